@@ -160,6 +160,7 @@ type AdminClient struct {
 	handle    *handle
 	isDerived bool   // Derived from existing client handle
 	isClosed  uint32 // to check if Admin Client is closed or not.
+	termChan  chan bool
 }
 
 // IsClosed returns boolean representing if client is closed or not
@@ -455,6 +456,15 @@ type AlterConsumerGroupOffsetsResult struct {
 	ConsumerGroupsTopicPartitions []ConsumerGroupTopicPartitions
 }
 
+// DeleteConsumerGroupOffsetsResult represents the result of a
+// DeleteConsumerGroupOffsets operation.
+type DeleteConsumerGroupOffsetsResult struct {
+	// A slice of ConsumerGroupTopicPartitions, each element represents a group's
+	// TopicPartitions, with the error of deleting the committed offset of
+	// each partition.
+	ConsumerGroupsTopicPartitions []ConsumerGroupTopicPartitions
+}
+
 // TopicSpecification holds parameters for creating a new topic.
 // TopicSpecification is analogous to NewTopic in the Java Topic Admin API.
 type TopicSpecification struct {
@@ -2737,6 +2747,11 @@ func (a *AdminClient) Close() {
 		return
 	}
 
+	if a.termChan != nil {
+		close(a.termChan)
+		a.handle.waitGroup.Wait()
+	}
+
 	a.handle.cleanup()
 
 	C.rd_kafka_destroy(a.handle.rk)
@@ -3309,6 +3324,105 @@ func (a *AdminClient) AlterConsumerGroupOffsets(
 	return acgor, nil
 }
 
+// DeleteConsumerGroupOffsets deletes the committed offsets for topic
+// partition(s) of consumer group(s).
+//
+// Parameters:
+//   - `ctx` - context with the maximum amount of time to block, or nil for
+//     indefinite.
+//   - `groupsPartitions` - a slice of ConsumerGroupTopicPartitions, each element of
+//     which has the id of a consumer group, and a slice of the TopicPartitions
+//     we need to delete the committed offsets for. Only the topic and
+//     partition fields are used. Currently, the size of `groupsPartitions`
+//     has to be exactly one.
+//   - `options` - DeleteConsumerGroupOffsetsAdminOption options.
+//
+// Returns a DeleteConsumerGroupOffsetsResult, containing a slice of
+// ConsumerGroupTopicPartitions corresponding to the input slice, plus an error
+// that is not `nil` for client level errors. Individual TopicPartitions inside
+// each of the ConsumerGroupTopicPartitions should also be checked for errors.
+// This will succeed at the partition level only if the group is not actively
+// subscribed to the corresponding topic(s), otherwise the partition's error
+// is ErrGroupSubscribedToTopic.
+func (a *AdminClient) DeleteConsumerGroupOffsets(
+	ctx context.Context, groupsPartitions []ConsumerGroupTopicPartitions,
+	options ...DeleteConsumerGroupOffsetsAdminOption) (dcgor DeleteConsumerGroupOffsetsResult, err error) {
+	err = a.verifyClient()
+	if err != nil {
+		return dcgor, err
+	}
+
+	dcgor.ConsumerGroupsTopicPartitions = nil
+
+	// For now, we only support one group at a time given as a single element of groupsPartitions.
+	// Code has been written so that only this if-guard needs to be removed when we add support for
+	// multiple ConsumerGroupTopicPartitions.
+	if len(groupsPartitions) != 1 {
+		return dcgor, fmt.Errorf(
+			"expected length of groupsPartitions is 1, got %d",
+			len(groupsPartitions))
+	}
+
+	cGroupsPartitions := make(
+		[]*C.rd_kafka_DeleteConsumerGroupOffsets_t, len(groupsPartitions))
+
+	// Convert Go ConsumerGroupTopicPartitions to C DeleteConsumerGroupOffsets.
+	for idx, groupPartitions := range groupsPartitions {
+		// We need to destroy this list because rd_kafka_DeleteConsumerGroupOffsets_new
+		// creates a copy of it.
+		cPartitions := newCPartsFromTopicPartitions(groupPartitions.Partitions)
+		defer C.rd_kafka_topic_partition_list_destroy(cPartitions)
+
+		cGroupID := C.CString(groupPartitions.Group)
+		defer C.free(unsafe.Pointer(cGroupID))
+
+		cGroupsPartitions[idx] =
+			C.rd_kafka_DeleteConsumerGroupOffsets_new(cGroupID, cPartitions)
+		defer C.rd_kafka_DeleteConsumerGroupOffsets_destroy(cGroupsPartitions[idx])
+	}
+
+	// Convert Go AdminOptions (if any) to C AdminOptions.
+	genericOptions := make([]AdminOption, len(options))
+	for i := range options {
+		genericOptions[i] = options[i]
+	}
+	cOptions, err := adminOptionsSetup(
+		a.handle, C.RD_KAFKA_ADMIN_OP_DELETECONSUMERGROUPOFFSETS, genericOptions)
+	if err != nil {
+		return dcgor, err
+	}
+	defer C.rd_kafka_AdminOptions_destroy(cOptions)
+
+	// Create temporary queue for async operation.
+	cQueue := C.rd_kafka_queue_new(a.handle.rk)
+	defer C.rd_kafka_queue_destroy(cQueue)
+
+	// Call rd_kafka_DeleteConsumerGroupOffsets (asynchronous).
+	C.rd_kafka_DeleteConsumerGroupOffsets(
+		a.handle.rk,
+		(**C.rd_kafka_DeleteConsumerGroupOffsets_t)(&cGroupsPartitions[0]),
+		C.size_t(len(cGroupsPartitions)),
+		cOptions,
+		cQueue)
+
+	// Wait for result, error or context timeout.
+	rkev, err := a.waitResult(
+		ctx, cQueue, C.RD_KAFKA_EVENT_DELETECONSUMERGROUPOFFSETS_RESULT)
+	if err != nil {
+		return dcgor, err
+	}
+	defer C.rd_kafka_event_destroy(rkev)
+
+	cRes := C.rd_kafka_event_DeleteConsumerGroupOffsets_result(rkev)
+
+	// Convert result from C to Go.
+	var cGroupCount C.size_t
+	cGroups := C.rd_kafka_DeleteConsumerGroupOffsets_result_groups(cRes, &cGroupCount)
+	dcgor.ConsumerGroupsTopicPartitions = a.cToConsumerGroupTopicPartitions(cGroups, cGroupCount)
+
+	return dcgor, nil
+}
+
 // DescribeUserScramCredentials describe SASL/SCRAM credentials for the
 // specified user names.
 //
@@ -3715,6 +3829,12 @@ func (a *AdminClient) ElectLeaders(ctx context.Context, electLeaderRequest Elect
 }
 
 // NewAdminClient creats a new AdminClient instance with a new underlying client instance
+//
+// conf is a *ConfigMap with standard librdkafka configuration properties.
+//
+// Supported special configuration properties (type, default):
+//
+//	go.oauthbearer.token.provider (kafka.OAuthBearerTokenProvider, nil) - Retrieve and set the SASL/OAUTHBEARER tokens with the provider.
 func NewAdminClient(conf *ConfigMap) (*AdminClient, error) {
 
 	err := versionCheck()
@@ -3725,8 +3845,15 @@ func NewAdminClient(conf *ConfigMap) (*AdminClient, error) {
 	a := &AdminClient{}
 	a.handle = &handle{}
 
+	confCopy := conf.clone()
+
+	tokenProvider, err := confCopy.extractOAuthBearerTokenProvider()
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert ConfigMap to librdkafka conf_t
-	cConf, err := conf.convert()
+	cConf, err := confCopy.convert()
 	if err != nil {
 		return nil, err
 	}
@@ -3736,6 +3863,10 @@ func NewAdminClient(conf *ConfigMap) (*AdminClient, error) {
 
 	C.rd_kafka_conf_set_events(cConf, C.RD_KAFKA_EVENT_STATS|C.RD_KAFKA_EVENT_ERROR|C.RD_KAFKA_EVENT_OAUTHBEARER_TOKEN_REFRESH)
 
+	if tokenProvider != nil {
+		enableSaslQueue(cConf)
+	}
+
 	// Create librdkafka producer instance. The Producer is somewhat cheaper than
 	// the consumer, but any instance type can be used for Admin APIs.
 	a.handle.rk = C.rd_kafka_new(C.RD_KAFKA_PRODUCER, cConf, cErrstr, 256)
@@ -3748,6 +3879,11 @@ func NewAdminClient(conf *ConfigMap) (*AdminClient, error) {
 
 	a.isClosed = 0
 
+	if tokenProvider != nil {
+		a.termChan = make(chan bool)
+		a.handle.setupOAuthBearerTokenProvider(tokenProvider, a.termChan)
+	}
+
 	return a, nil
 }
 
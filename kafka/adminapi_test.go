@@ -721,6 +721,41 @@ func testAdminAPIsAlterConsumerGroupOffsets(
 	}
 }
 
+func testAdminAPIsDeleteConsumerGroupOffsets(
+	what string, a *AdminClient, expDuration time.Duration, t *testing.T) {
+	topic := "topic"
+	ctx, cancel := context.WithTimeout(context.Background(), expDuration)
+	defer cancel()
+	dres, err := a.DeleteConsumerGroupOffsets(
+		ctx,
+		[]ConsumerGroupTopicPartitions{
+			{
+				"test",
+				[]TopicPartition{
+					{
+						Topic:     &topic,
+						Partition: 0,
+					},
+				},
+			},
+		})
+	if dres.ConsumerGroupsTopicPartitions != nil || err == nil {
+		t.Fatalf("Expected DeleteConsumerGroupOffsets to fail, but got result: %v, err: %v",
+			dres, err)
+	}
+	if ctx.Err() != context.DeadlineExceeded {
+		t.Fatalf("Expected DeadlineExceeded, not %v", ctx.Err())
+	}
+
+	// Only a single group is supported
+	dres, err = a.DeleteConsumerGroupOffsets(
+		context.Background(), []ConsumerGroupTopicPartitions{})
+	if dres.ConsumerGroupsTopicPartitions != nil || err == nil {
+		t.Fatalf("Expected DeleteConsumerGroupOffsets to fail for no groups, but got result: %v, err: %v",
+			dres, err)
+	}
+}
+
 func testAdminAPIsListOffsets(
 	what string, a *AdminClient, expDuration time.Duration, t *testing.T) {
 	topic := "test"
@@ -1245,6 +1280,7 @@ func testAdminAPIs(what string, a *AdminClient, t *testing.T) {
 
 	testAdminAPIsListConsumerGroupOffsets(what, a, expDuration, t)
 	testAdminAPIsAlterConsumerGroupOffsets(what, a, expDuration, t)
+	testAdminAPIsDeleteConsumerGroupOffsets(what, a, expDuration, t)
 	testAdminAPIsListOffsets(what, a, expDuration, t)
 
 	testAdminAPIsUserScramCredentials(what, a, expDuration, t)
@@ -141,6 +141,8 @@ func (ao AdminOptionRequestTimeout) supportsListConsumerGroupOffsets() {
 }
 func (ao AdminOptionRequestTimeout) supportsAlterConsumerGroupOffsets() {
 }
+func (ao AdminOptionRequestTimeout) supportsDeleteConsumerGroupOffsets() {
+}
 func (ao AdminOptionRequestTimeout) supportsListOffsets() {
 }
 func (ao AdminOptionRequestTimeout) supportsDescribeUserScramCredentials() {
@@ -597,6 +599,14 @@ type AlterConsumerGroupOffsetsAdminOption interface {
 	apply(cOptions *C.rd_kafka_AdminOptions_t) error
 }
 
+// DeleteConsumerGroupOffsetsAdminOption - see setter.
+//
+// See SetAdminRequestTimeout.
+type DeleteConsumerGroupOffsetsAdminOption interface {
+	supportsDeleteConsumerGroupOffsets()
+	apply(cOptions *C.rd_kafka_AdminOptions_t) error
+}
+
 // DescribeUserScramCredentialsAdminOption - see setter.
 //
 // See SetAdminRequestTimeout.
@@ -19,6 +19,7 @@ package kafka
 import (
 	"fmt"
 	"go/types"
+	"log/slog"
 	"reflect"
 	"strings"
 	"unsafe"
@@ -257,7 +258,7 @@ func (m ConfigMap) extract(key string, defval ConfigValue) (ConfigValue, error)
 }
 
 // extractLogConfig extracts generic go.logs.* configuration properties.
-func (m ConfigMap) extractLogConfig() (logsChanEnable bool, logsChan chan LogEvent, err error) {
+func (m ConfigMap) extractLogConfig() (logsChanEnable bool, logsChan chan LogEvent, logger *slog.Logger, err error) {
 	v, err := m.extract("go.logs.channel.enable", false)
 	if err != nil {
 		return
@@ -274,14 +275,58 @@ func (m ConfigMap) extractLogConfig() (logsChanEnable bool, logsChan chan LogEve
 		logsChan = v.(chan LogEvent)
 	}
 
-	if logsChanEnable {
-		// Tell librdkafka to forward logs to the log queue
+	v, err = m.extract("go.logs.slog", nil)
+	if err != nil {
+		return
+	}
+
+	if v != nil {
+		var ok bool
+		logger, ok = v.(*slog.Logger)
+		if !ok || logger == nil {
+			err = newErrorFromString(ErrInvalidArg,
+				fmt.Sprintf("go.logs.slog expects type *slog.Logger, not %T", v))
+			return
+		}
+
+		if logsChanEnable {
+			err = newErrorFromString(ErrInvalidArg,
+				"go.logs.slog and go.logs.channel.enable are mutually exclusive")
+			return
+		}
+	}
+
+	if logsChanEnable || logger != nil {
+		// Tell librdkafka to forward logs to the log queue.
+		// Logs emitted before the log queue is set up, e.g., while the
+		// client instance is created, are held until then.
 		m.Set("log.queue=true")
 	}
 
 	return
 }
 
+// extractStatsCb extracts the go.stats.cb configuration property.
+func (m ConfigMap) extractStatsCb() (statsCb StatsCb, err error) {
+	v, err := m.extract("go.stats.cb", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	switch cb := v.(type) {
+	case nil:
+	case StatsCb:
+		statsCb = cb
+	case func(*Stats):
+		statsCb = cb
+	default:
+		return nil, newErrorFromString(ErrInvalidArg,
+			fmt.Sprintf("go.stats.cb expects type kafka.StatsCb, not %T", v))
+	}
+
+	return statsCb, nil
+}
+
 func (m ConfigMap) clone() ConfigMap {
 	m2 := make(ConfigMap)
 	for k, v := range m {
@@ -0,0 +1,863 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kafka
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// configScope is the set of client types a configuration property
+// applies to.
+type configScope int
+
+const (
+	configScopeProducer configScope = 1 << iota
+	configScopeConsumer
+	configScopeAdmin
+	configScopeAll = configScopeProducer | configScopeConsumer | configScopeAdmin
+)
+
+func (s configScope) String() string {
+	var names []string
+	if s&configScopeProducer != 0 {
+		names = append(names, "producer")
+	}
+	if s&configScopeConsumer != 0 {
+		names = append(names, "consumer")
+	}
+	if s&configScopeAdmin != 0 {
+		names = append(names, "admin client")
+	}
+	return strings.Join(names, " and ")
+}
+
+// configType is the type of a librdkafka configuration property.
+type configType int
+
+const (
+	configTypeString configType = iota
+	configTypeInt
+	configTypeBool
+	configTypeFloat
+	configTypeEnum
+	configTypeFlags
+	// configTypePointer properties are set through dedicated APIs and
+	// can't be set from a ConfigMap.
+	configTypePointer
+)
+
+// configProperty is a librdkafka configuration property, see
+// configProperties in generated_config.go.
+type configProperty struct {
+	name       string
+	scope      configScope
+	typ        configType
+	min, max   float64
+	values     []string
+	alias      string
+	deprecated bool
+}
+
+// AcksAll is the value of the acks configuration property waiting for all
+// the in-sync replicas to acknowledge the produced messages.
+const AcksAll = -1
+
+// joinFlags returns the value of a CSV flags configuration property.
+func joinFlags[T ~string](flags []T) string {
+	values := make([]string, len(flags))
+	for i, flag := range flags {
+		values[i] = string(flag)
+	}
+	return strings.Join(values, ",")
+}
+
+// stringValue returns value as a string if it is a string, of any named
+// string type, or implements fmt.Stringer.
+func stringValue(value ConfigValue) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case fmt.Stringer:
+		return v.String(), true
+	}
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.String {
+		return rv.String(), true
+	}
+	return "", false
+}
+
+// validate returns value converted to the Go type of the property: int,
+// bool, float64 or string, or the reason value is invalid.
+func (p *configProperty) validate(value ConfigValue) (ConfigValue, string) {
+	switch p.typ {
+	case configTypePointer:
+		return nil, fmt.Sprintf("%s can not be set from Go", p.name)
+
+	case configTypeInt:
+		var i int
+		switch v := value.(type) {
+		case int:
+			i = v
+		default:
+			s, ok := stringValue(value)
+			if !ok {
+				return nil, fmt.Sprintf("%s expects type int, not %T", p.name, value)
+			}
+			if s == "all" && p.name == "request.required.acks" {
+				i = AcksAll
+			} else if n, err := strconv.Atoi(s); err == nil {
+				i = n
+			} else {
+				return nil, fmt.Sprintf("%s expects an integer, not \"%s\"", p.name, s)
+			}
+		}
+		if float64(i) < p.min || float64(i) > p.max {
+			return nil, fmt.Sprintf("%s must be in the range %v .. %v, not %d", p.name, p.min, p.max, i)
+		}
+		return i, ""
+
+	case configTypeBool:
+		switch v := value.(type) {
+		case bool:
+			return v, ""
+		default:
+			s, ok := stringValue(value)
+			if !ok {
+				return nil, fmt.Sprintf("%s expects type bool, not %T", p.name, value)
+			}
+			b, err := strconv.ParseBool(s)
+			if err != nil {
+				return nil, fmt.Sprintf("%s expects true or false, not \"%s\"", p.name, s)
+			}
+			return b, ""
+		}
+
+	case configTypeFloat:
+		var f float64
+		switch v := value.(type) {
+		case float64:
+			f = v
+		case int:
+			f = float64(v)
+		default:
+			s, ok := stringValue(value)
+			if !ok {
+				return nil, fmt.Sprintf("%s expects type float64, not %T", p.name, value)
+			}
+			var err error
+			if f, err = strconv.ParseFloat(s, 64); err != nil {
+				return nil, fmt.Sprintf("%s expects a number, not \"%s\"", p.name, s)
+			}
+		}
+		if f < p.min || f > p.max {
+			return nil, fmt.Sprintf("%s must be in the range %v .. %v, not %v", p.name, p.min, p.max, f)
+		}
+		return f, ""
+
+	case configTypeEnum, configTypeFlags:
+		s, ok := stringValue(value)
+		if !ok {
+			return nil, fmt.Sprintf("%s expects type string, not %T", p.name, value)
+		}
+		if p.typ == configTypeEnum {
+			if v, found := p.findValue(s); found {
+				return v, ""
+			}
+			return nil, fmt.Sprintf("%s expects one of %s, not \"%s\"",
+				p.name, strings.Join(p.values, ", "), s)
+		}
+		var flags []string
+		for _, flag := range strings.Split(s, ",") {
+			if flag = strings.TrimSpace(flag); flag == "" {
+				continue
+			}
+			v, found := p.findValue(flag)
+			if !found {
+				return nil, fmt.Sprintf("%s expects flags of %s, not \"%s\"",
+					p.name, strings.Join(p.values, ", "), flag)
+			}
+			flags = append(flags, v)
+		}
+		return strings.Join(flags, ","), ""
+
+	default:
+		s, ok := stringValue(value)
+		if !ok {
+			return nil, fmt.Sprintf("%s expects type string, not %T", p.name, value)
+		}
+		return s, ""
+	}
+}
+
+// findValue returns the value of the enum or flags property matching s,
+// which like librdkafka is case-insensitive.
+func (p *configProperty) findValue(s string) (string, bool) {
+	for _, v := range p.values {
+		if strings.EqualFold(v, s) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// goConfigProperty is a configuration property of the Go client, handled
+// by NewProducer(), NewConsumer() and NewAdminClient() rather than
+// librdkafka.
+type goConfigProperty struct {
+	scope configScope
+	// validate returns the reason value is invalid, if it is.
+	validate func(name string, value ConfigValue) string
+}
+
+// goTypeValidator returns a goConfigProperty validator of the values of
+// type T, which must not be nil channels or pointers.
+func goTypeValidator[T any](typeName string) func(name string, value ConfigValue) string {
+	return func(name string, value ConfigValue) string {
+		v, ok := value.(T)
+		if !ok {
+			return fmt.Sprintf("%s expects type %s, not %T", name, typeName, value)
+		}
+		if rv := reflect.ValueOf(v); (rv.Kind() == reflect.Chan || rv.Kind() == reflect.Pointer) && rv.IsNil() {
+			return fmt.Sprintf("%s must not be nil", name)
+		}
+		return ""
+	}
+}
+
+// goSizeValidator validates the size of a channel.
+func goSizeValidator(name string, value ConfigValue) string {
+	size, ok := value.(int)
+	if !ok {
+		return fmt.Sprintf("%s expects type int, not %T", name, value)
+	}
+	if size < 0 {
+		return fmt.Sprintf("%s must be >= 0, not %d", name, size)
+	}
+	return ""
+}
+
+// goExtractValidator validates a value with the extract function of the
+// property, as NewProducer() and NewConsumer() do.
+func goExtractValidator(extract func(m ConfigMap) error) func(name string, value ConfigValue) string {
+	return func(name string, value ConfigValue) string {
+		if err := extract(ConfigMap{name: value}); err != nil {
+			return err.Error()
+		}
+		return ""
+	}
+}
+
+// goConfigProperties are the configuration properties of the Go client,
+// by name.
+var goConfigProperties = map[string]goConfigProperty{
+	"go.batch.producer":   {configScopeProducer, goTypeValidator[bool]("bool")},
+	"go.delivery.reports": {configScopeProducer, goTypeValidator[bool]("bool")},
+	"go.delivery.report.fields": {configScopeProducer,
+		func(name string, value ConfigValue) string {
+			s, ok := value.(string)
+			if !ok {
+				return fmt.Sprintf("%s expects type string, not %T", name, value)
+			}
+			if _, err := newMessageFieldsFrom(s); err != nil {
+				return fmt.Sprintf("%s: %s", name, err)
+			}
+			return ""
+		}},
+	"go.events.channel.size":          {configScopeProducer | configScopeConsumer, goSizeValidator},
+	"go.produce.channel.size":         {configScopeProducer, goSizeValidator},
+	"go.application.rebalance.enable": {configScopeConsumer, goTypeValidator[bool]("bool")},
+	"go.rebalance.commit.on.revoke":   {configScopeConsumer, goTypeValidator[bool]("bool")},
+	"go.events.channel.enable":        {configScopeConsumer, goTypeValidator[bool]("bool")},
+	"go.partition.queues.enable":      {configScopeConsumer, goTypeValidator[bool]("bool")},
+	"go.logs.channel.enable":          {configScopeProducer | configScopeConsumer, goTypeValidator[bool]("bool")},
+	"go.logs.channel":                 {configScopeProducer | configScopeConsumer, goTypeValidator[chan LogEvent]("chan kafka.LogEvent")},
+	"go.logs.slog":                    {configScopeProducer | configScopeConsumer, goTypeValidator[*slog.Logger]("*slog.Logger")},
+	"go.stats.cb": {configScopeProducer | configScopeConsumer,
+		goExtractValidator(func(m ConfigMap) error {
+			cb, err := m.extractStatsCb()
+			if err == nil && cb == nil {
+				err = newErrorFromString(ErrInvalidArg, "go.stats.cb must not be nil")
+			}
+			return err
+		})},
+	"go.partitioner": {configScopeProducer,
+		goExtractValidator(func(m ConfigMap) error {
+			_, _, err := m.extractPartitioners()
+			return err
+		})},
+	"go.topic.partitioners": {configScopeProducer,
+		goExtractValidator(func(m ConfigMap) error {
+			_, _, err := m.extractPartitioners()
+			return err
+		})},
+	"go.producer.interceptors": {configScopeProducer,
+		goExtractValidator(func(m ConfigMap) error {
+			_, err := m.extractProducerInterceptors()
+			return err
+		})},
+	"go.consumer.interceptors": {configScopeConsumer,
+		goExtractValidator(func(m ConfigMap) error {
+			_, err := m.extractConsumerInterceptors()
+			return err
+		})},
+	"go.oauthbearer.token.provider": {configScopeAll,
+		goExtractValidator(func(m ConfigMap) error {
+			_, err := m.extractOAuthBearerTokenProvider()
+			return err
+		})},
+}
+
+// configBuilder builds and validates the configuration of a client of
+// scope, see ProducerConfig, ConsumerConfig and AdminConfig.
+type configBuilder struct {
+	scope configScope
+	conf  ConfigMap
+}
+
+func newConfigBuilder(scope configScope) configBuilder {
+	return configBuilder{scope: scope, conf: make(ConfigMap)}
+}
+
+// set sets the configuration property name to value, which is validated
+// by build().
+func (c *configBuilder) set(name string, value ConfigValue) {
+	c.conf[name] = value
+}
+
+// configValues are the validated values of a configuration, by canonical
+// property name.
+type configValues map[string]ConfigValue
+
+func (v configValues) isSet(name string) bool {
+	_, found := v[name]
+	return found
+}
+
+// setInvalid marks the property name as set to an invalid value, which
+// is reported by itself rather than as a missing property.
+func (v configValues) setInvalid(name string) {
+	if _, found := v[name]; !found {
+		v[name] = nil
+	}
+}
+
+// isTrue returns true if the boolean property name is set to true.
+func (v configValues) isTrue(name string) bool {
+	b, ok := v[name].(bool)
+	return ok && b
+}
+
+// isFalse returns true if the boolean property name is set to false.
+func (v configValues) isFalse(name string) bool {
+	b, ok := v[name].(bool)
+	return ok && !b
+}
+
+// number returns the value of the int or float property name, or defval
+// if it is not set.
+func (v configValues) number(name string, defval float64) float64 {
+	switch n := v[name].(type) {
+	case int:
+		return float64(n)
+	case float64:
+		return n
+	}
+	return defval
+}
+
+// build validates the configuration, returning it as a ConfigMap of
+// values librdkafka and the client constructors accept.
+func (c *configBuilder) build() (*ConfigMap, error) {
+	var errs []string
+	conf := make(ConfigMap, len(c.conf))
+	values := make(configValues, len(c.conf))
+	setAs := make(map[string]string, len(c.conf))
+
+	_, hasPlugins := c.conf["plugin.library.paths"]
+
+	for name, value := range c.conf {
+		if gp, found := goConfigProperties[name]; found {
+			if gp.scope&c.scope == 0 {
+				errs = append(errs, fmt.Sprintf("%s is a %s property", name, gp.scope))
+				continue
+			}
+			if reason := gp.validate(name, value); reason != "" {
+				errs = append(errs, reason)
+				values.setInvalid(name)
+				continue
+			}
+			conf[name] = value
+			values[name] = value
+			continue
+		}
+
+		if name == "default.topic.config" {
+			errs = append(errs, "default.topic.config is deprecated, "+
+				"set the topic configuration properties directly")
+			continue
+		}
+
+		p, found := configProperties[name]
+		if !found {
+			if hasPlugins && !strings.HasPrefix(name, "go.") {
+				// Plugin-specific property, validated by the plugin.
+				conf[name] = value
+				continue
+			}
+			errs = append(errs, fmt.Sprintf("Unknown configuration property %s", name))
+			continue
+		}
+
+		if p.scope&c.scope == 0 {
+			errs = append(errs, fmt.Sprintf("%s is a %s property", name, p.scope))
+			continue
+		}
+
+		canonical := p
+		if p.alias != "" {
+			if t, found := configProperties[p.alias]; found {
+				canonical = t
+			}
+		}
+
+		v, reason := canonical.validate(value)
+		if reason != "" {
+			// Report the name the property was set as.
+			errs = append(errs, strings.Replace(reason, canonical.name, name, 1))
+			values.setInvalid(canonical.name)
+			continue
+		}
+
+		if other, found := setAs[canonical.name]; found {
+			first, second := other, name
+			if first > second {
+				first, second = second, first
+			}
+			errs = append(errs, fmt.Sprintf("%s and %s are the same property, set only one", first, second))
+			continue
+		}
+		setAs[canonical.name] = name
+		values[canonical.name] = v
+
+		if f, ok := v.(float64); ok {
+			conf[name] = strconv.FormatFloat(f, 'f', -1, 64)
+		} else {
+			conf[name] = v
+		}
+	}
+
+	errs = append(errs, values.validate(c.scope, setAs)...)
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return nil, newErrorFromString(ErrInvalidArg,
+			"Invalid configuration: "+strings.Join(errs, "; "))
+	}
+
+	return &conf, nil
+}
+
+// validate checks the rules spanning several properties of a client of
+// scope, returning the violations. setAs are the names the properties
+// were set as, by canonical name.
+func (v configValues) validate(scope configScope, setAs map[string]string) []string {
+	var errs []string
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Sprintf(format, args...))
+	}
+	nameOf := func(canonical string) string {
+		if name, found := setAs[canonical]; found {
+			return name
+		}
+		return canonical
+	}
+	exclusive := func(a, b string) {
+		if v.isSet(a) && v.isSet(b) {
+			fail("%s and %s are mutually exclusive", nameOf(a), nameOf(b))
+		}
+	}
+
+	// SSL keys, certificates and keystores.
+	exclusive("ssl.key.location", "ssl.key.pem")
+	exclusive("ssl.certificate.location", "ssl.certificate.pem")
+	exclusive("ssl.ca.location", "ssl.ca.pem")
+	for _, name := range []string{"ssl.key.location", "ssl.key.pem",
+		"ssl.certificate.location", "ssl.certificate.pem"} {
+		exclusive("ssl.keystore.location", name)
+	}
+
+	// OAUTHBEARER.
+	if v["sasl.oauthbearer.method"] == "oidc" {
+		for _, name := range []string{"sasl.oauthbearer.client.id",
+			"sasl.oauthbearer.token.endpoint.url"} {
+			if !v.isSet(name) {
+				fail("%s is required with sasl.oauthbearer.method=oidc", name)
+			}
+		}
+		if v.isTrue("enable.sasl.oauthbearer.unsecure.jwt") {
+			fail("enable.sasl.oauthbearer.unsecure.jwt and sasl.oauthbearer.method=oidc are mutually exclusive")
+		}
+		if v.isSet("go.oauthbearer.token.provider") {
+			fail("go.oauthbearer.token.provider and sasl.oauthbearer.method=oidc are mutually exclusive")
+		}
+	}
+	if v.isTrue("enable.sasl.oauthbearer.unsecure.jwt") && v.isSet("go.oauthbearer.token.provider") {
+		fail("go.oauthbearer.token.provider and enable.sasl.oauthbearer.unsecure.jwt are mutually exclusive")
+	}
+
+	// Logs.
+	if v.isSet("go.logs.slog") && v.isTrue("go.logs.channel.enable") {
+		fail("go.logs.slog and go.logs.channel.enable are mutually exclusive")
+	}
+	if v.isSet("go.logs.channel") && !v.isTrue("go.logs.channel.enable") {
+		fail("go.logs.channel requires go.logs.channel.enable=true")
+	}
+
+	if scope == configScopeProducer {
+		if v.isTrue("enable.idempotence") {
+			if v.isSet("request.required.acks") && v.number("request.required.acks", 0) != AcksAll {
+				fail("%s must be all (-1) with enable.idempotence=true", nameOf("request.required.acks"))
+			}
+			if v.number("max.in.flight.requests.per.connection", 0) > 5 {
+				fail("%s must be <= 5 with enable.idempotence=true", nameOf("max.in.flight.requests.per.connection"))
+			}
+			if v.isSet("message.send.max.retries") && v.number("message.send.max.retries", 0) < 1 {
+				fail("%s must be >= 1 with enable.idempotence=true", nameOf("message.send.max.retries"))
+			}
+		}
+		if v.isSet("transactional.id") && v.isFalse("enable.idempotence") {
+			fail("transactional.id requires enable.idempotence=true")
+		}
+
+		if v.isTrue("delivery.report.only.error") {
+			fail("delivery.report.only.error=true is not currently supported by the Go client")
+		}
+
+		if v.isSet("message.timeout.ms") || v.isSet("queue.buffering.max.ms") {
+			timeout := v.number("message.timeout.ms", 300000)
+			if timeout != 0 && timeout <= v.number("queue.buffering.max.ms", 5) {
+				fail("%s must be greater than %s",
+					nameOf("message.timeout.ms"), nameOf("queue.buffering.max.ms"))
+			}
+		}
+
+		exclusive("go.partitioner", "partitioner")
+	}
+
+	if scope == configScopeConsumer {
+		if !v.isSet("group.id") {
+			fail("Required property group.id not set")
+		}
+
+		if v["group.protocol"] == "consumer" {
+			for _, name := range []string{"partition.assignment.strategy",
+				"session.timeout.ms", "heartbeat.interval.ms", "group.protocol.type"} {
+				if v.isSet(name) {
+					fail("%s is not supported with group.protocol=consumer, "+
+						"it is configured on the broker", name)
+				}
+			}
+		}
+
+		if v.isSet("fetch.max.bytes") &&
+			v.number("fetch.max.bytes", 0) < v.number("message.max.bytes", 1000000) {
+			fail("fetch.max.bytes must be >= %s", nameOf("message.max.bytes"))
+		}
+	}
+
+	return errs
+}
+
+// ProducerConfig builds the configuration of a Producer, with typed
+// setters of the producer configuration properties, validating it against
+// the librdkafka configuration properties on Build():
+//
+//	conf, err := kafka.NewProducerConfig().
+//		BootstrapServers("localhost:9092").
+//		EnableIdempotence(true).
+//		CompressionType(kafka.CompressionCodecLz4).
+//		LingerMs(5).
+//		Build()
+//	if err != nil {
+//		...
+//	}
+//	p, err := kafka.NewProducer(conf)
+type ProducerConfig struct {
+	configBuilder
+}
+
+// NewProducerConfig returns a new, empty, ProducerConfig.
+func NewProducerConfig() *ProducerConfig {
+	return &ProducerConfig{newConfigBuilder(configScopeProducer)}
+}
+
+// Set sets the configuration property name to value, for the properties
+// without a typed setter such as plugin-specific properties.
+func (c *ProducerConfig) Set(name string, value ConfigValue) *ProducerConfig {
+	c.set(name, value)
+	return c
+}
+
+// Build validates the configuration, returning it as a ConfigMap for
+// NewProducer(), or an ErrInvalidArg error listing all the invalid
+// properties.
+func (c *ProducerConfig) Build() (*ConfigMap, error) {
+	return c.build()
+}
+
+// ConsumerConfig builds the configuration of a Consumer, with typed
+// setters of the consumer configuration properties, validating it against
+// the librdkafka configuration properties on Build(), see ProducerConfig.
+type ConsumerConfig struct {
+	configBuilder
+}
+
+// NewConsumerConfig returns a new, empty, ConsumerConfig.
+func NewConsumerConfig() *ConsumerConfig {
+	return &ConsumerConfig{newConfigBuilder(configScopeConsumer)}
+}
+
+// Set sets the configuration property name to value, for the properties
+// without a typed setter such as plugin-specific properties.
+func (c *ConsumerConfig) Set(name string, value ConfigValue) *ConsumerConfig {
+	c.set(name, value)
+	return c
+}
+
+// Build validates the configuration, returning it as a ConfigMap for
+// NewConsumer(), or an ErrInvalidArg error listing all the invalid
+// properties.
+func (c *ConsumerConfig) Build() (*ConfigMap, error) {
+	return c.build()
+}
+
+// AdminConfig builds the configuration of an AdminClient, with typed
+// setters of the global configuration properties, validating it against
+// the librdkafka configuration properties on Build(), see ProducerConfig.
+type AdminConfig struct {
+	configBuilder
+}
+
+// NewAdminConfig returns a new, empty, AdminConfig.
+func NewAdminConfig() *AdminConfig {
+	return &AdminConfig{newConfigBuilder(configScopeAdmin)}
+}
+
+// Set sets the configuration property name to value, for the properties
+// without a typed setter such as plugin-specific properties.
+func (c *AdminConfig) Set(name string, value ConfigValue) *AdminConfig {
+	c.set(name, value)
+	return c
+}
+
+// Build validates the configuration, returning it as a ConfigMap for
+// NewAdminClient(), or an ErrInvalidArg error listing all the invalid
+// properties.
+func (c *AdminConfig) Build() (*ConfigMap, error) {
+	return c.build()
+}
+
+// GoBatchProducer sets the go.batch.producer configuration property:
+// enable batch producer (for increased performance).
+func (c *ProducerConfig) GoBatchProducer(value bool) *ProducerConfig {
+	c.set("go.batch.producer", value)
+	return c
+}
+
+// GoDeliveryReports sets the go.delivery.reports configuration property:
+// forward per-message delivery reports to the Events() channel.
+func (c *ProducerConfig) GoDeliveryReports(value bool) *ProducerConfig {
+	c.set("go.delivery.reports", value)
+	return c
+}
+
+// GoDeliveryReportFields sets the go.delivery.report.fields configuration
+// property: the message fields to populate in delivery reports, of key,
+// value and headers, or all or none.
+func (c *ProducerConfig) GoDeliveryReportFields(fields ...string) *ProducerConfig {
+	c.set("go.delivery.report.fields", strings.Join(fields, ","))
+	return c
+}
+
+// GoEventsChannelSize sets the go.events.channel.size configuration
+// property: the size of the Events() channel.
+func (c *ProducerConfig) GoEventsChannelSize(value int) *ProducerConfig {
+	c.set("go.events.channel.size", value)
+	return c
+}
+
+// GoProduceChannelSize sets the go.produce.channel.size configuration
+// property: the size of the ProduceChannel() buffer.
+func (c *ProducerConfig) GoProduceChannelSize(value int) *ProducerConfig {
+	c.set("go.produce.channel.size", value)
+	return c
+}
+
+// GoLogsChannelEnable sets the go.logs.channel.enable configuration
+// property: forward logs to the Logs() channel.
+func (c *ProducerConfig) GoLogsChannelEnable(value bool) *ProducerConfig {
+	c.set("go.logs.channel.enable", value)
+	return c
+}
+
+// GoLogsChannel sets the go.logs.channel configuration property: forward
+// logs to logsChan instead of Logs(). Requires go.logs.channel.enable.
+func (c *ProducerConfig) GoLogsChannel(logsChan chan LogEvent) *ProducerConfig {
+	c.set("go.logs.channel", logsChan)
+	return c
+}
+
+// GoLogsSlog sets the go.logs.slog configuration property: log to logger
+// instead of Logs().
+func (c *ProducerConfig) GoLogsSlog(logger *slog.Logger) *ProducerConfig {
+	c.set("go.logs.slog", logger)
+	return c
+}
+
+// GoStatsCb sets the go.stats.cb configuration property: call cb with
+// each Stats event.
+func (c *ProducerConfig) GoStatsCb(cb StatsCb) *ProducerConfig {
+	c.set("go.stats.cb", cb)
+	return c
+}
+
+// GoPartitioner sets the go.partitioner configuration property: the Go
+// partitioner of the messages produced to PartitionAny.
+func (c *ProducerConfig) GoPartitioner(partitioner PartitionerFunc) *ProducerConfig {
+	c.set("go.partitioner", partitioner)
+	return c
+}
+
+// GoTopicPartitioners sets the go.topic.partitioners configuration
+// property: the Go partitioners of specific topics.
+func (c *ProducerConfig) GoTopicPartitioners(partitioners map[string]PartitionerFunc) *ProducerConfig {
+	c.set("go.topic.partitioners", partitioners)
+	return c
+}
+
+// GoProducerInterceptors sets the go.producer.interceptors configuration
+// property: the interceptors of the produced messages, called in order.
+func (c *ProducerConfig) GoProducerInterceptors(interceptors ...ProducerInterceptor) *ProducerConfig {
+	c.set("go.producer.interceptors", interceptors)
+	return c
+}
+
+// GoOAuthBearerTokenProvider sets the go.oauthbearer.token.provider
+// configuration property: retrieve and refresh the SASL/OAUTHBEARER tokens
+// with provider.
+func (c *ProducerConfig) GoOAuthBearerTokenProvider(provider OAuthBearerTokenProvider) *ProducerConfig {
+	c.set("go.oauthbearer.token.provider", provider)
+	return c
+}
+
+// GoApplicationRebalanceEnable sets the go.application.rebalance.enable
+// configuration property: forward rebalancing responsibility to the
+// application via the Events() channel.
+func (c *ConsumerConfig) GoApplicationRebalanceEnable(value bool) *ConsumerConfig {
+	c.set("go.application.rebalance.enable", value)
+	return c
+}
+
+// GoRebalanceCommitOnRevoke sets the go.rebalance.commit.on.revoke
+// configuration property: commit the offsets of the revoked partitions
+// before they are unassigned.
+func (c *ConsumerConfig) GoRebalanceCommitOnRevoke(value bool) *ConsumerConfig {
+	c.set("go.rebalance.commit.on.revoke", value)
+	return c
+}
+
+// GoEventsChannelEnable sets the go.events.channel.enable configuration
+// property: enable the Events() channel.
+//
+// Deprecated: the Events() channel of the Consumer is deprecated, use
+// Poll() or ReadMessage() instead.
+func (c *ConsumerConfig) GoEventsChannelEnable(value bool) *ConsumerConfig {
+	c.set("go.events.channel.enable", value)
+	return c
+}
+
+// GoEventsChannelSize sets the go.events.channel.size configuration
+// property: the size of the Events() channel.
+func (c *ConsumerConfig) GoEventsChannelSize(value int) *ConsumerConfig {
+	c.set("go.events.channel.size", value)
+	return c
+}
+
+// GoPartitionQueuesEnable sets the go.partition.queues.enable
+// configuration property: split the consumed messages of the assigned
+// partitions to their own queues, see PartitionQueue().
+func (c *ConsumerConfig) GoPartitionQueuesEnable(value bool) *ConsumerConfig {
+	c.set("go.partition.queues.enable", value)
+	return c
+}
+
+// GoLogsChannelEnable sets the go.logs.channel.enable configuration
+// property: forward logs to the Logs() channel.
+func (c *ConsumerConfig) GoLogsChannelEnable(value bool) *ConsumerConfig {
+	c.set("go.logs.channel.enable", value)
+	return c
+}
+
+// GoLogsChannel sets the go.logs.channel configuration property: forward
+// logs to logsChan instead of Logs(). Requires go.logs.channel.enable.
+func (c *ConsumerConfig) GoLogsChannel(logsChan chan LogEvent) *ConsumerConfig {
+	c.set("go.logs.channel", logsChan)
+	return c
+}
+
+// GoLogsSlog sets the go.logs.slog configuration property: log to logger
+// instead of Logs().
+func (c *ConsumerConfig) GoLogsSlog(logger *slog.Logger) *ConsumerConfig {
+	c.set("go.logs.slog", logger)
+	return c
+}
+
+// GoStatsCb sets the go.stats.cb configuration property: call cb with
+// each Stats event.
+func (c *ConsumerConfig) GoStatsCb(cb StatsCb) *ConsumerConfig {
+	c.set("go.stats.cb", cb)
+	return c
+}
+
+// GoConsumerInterceptors sets the go.consumer.interceptors configuration
+// property: the interceptors of the consumed messages and committed
+// offsets, called in order.
+func (c *ConsumerConfig) GoConsumerInterceptors(interceptors ...ConsumerInterceptor) *ConsumerConfig {
+	c.set("go.consumer.interceptors", interceptors)
+	return c
+}
+
+// GoOAuthBearerTokenProvider sets the go.oauthbearer.token.provider
+// configuration property: retrieve and refresh the SASL/OAUTHBEARER tokens
+// with provider.
+func (c *ConsumerConfig) GoOAuthBearerTokenProvider(provider OAuthBearerTokenProvider) *ConsumerConfig {
+	c.set("go.oauthbearer.token.provider", provider)
+	return c
+}
+
+// GoOAuthBearerTokenProvider sets the go.oauthbearer.token.provider
+// configuration property: retrieve and refresh the SASL/OAUTHBEARER tokens
+// with provider.
+func (c *AdminConfig) GoOAuthBearerTokenProvider(provider OAuthBearerTokenProvider) *AdminConfig {
+	c.set("go.oauthbearer.token.provider", provider)
+	return c
+}
@@ -0,0 +1,50 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kafka
+
+// Automatically generate the typed configuration from librdkafka
+// See README for instructions
+//go:generate $GOPATH/bin/go_rdkafka_genconf generated_config.go
+
+/*
+#include <stdio.h>
+#include <unistd.h>
+#include "select_rdkafka.h"
+
+static int conf_properties_show (int fd) {
+  FILE *fp = fdopen(dup(fd), "w");
+  if (!fp)
+    return -1;
+  rd_kafka_conf_properties_show(fp);
+  return fclose(fp);
+}
+*/
+import "C"
+
+import (
+	"os"
+)
+
+// WriteConfigProperties writes the table of the librdkafka configuration
+// properties, as in librdkafka's CONFIGURATION.md, to f.
+// This function is not intended for public use.
+func WriteConfigProperties(f *os.File) error {
+	if C.conf_properties_show(C.int(f.Fd())) != 0 {
+		return newErrorFromString(ErrFs, "Failed to write configuration properties")
+	}
+	return nil
+}
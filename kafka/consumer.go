@@ -17,8 +17,10 @@
 package kafka
 
 import (
+	"context"
 	"fmt"
 	"math"
+	"sync"
 	"sync/atomic"
 	"time"
 	"unsafe"
@@ -49,6 +51,14 @@ type Consumer struct {
 	appReassigned      bool
 	appRebalanceEnable bool // SerializerConfig setting
 
+	rebalanceListener    RebalanceListener
+	rebalanceListenerCtx context.Context
+	commitOnRevoke       bool // go.rebalance.commit.on.revoke
+
+	partitionQueuesEnable bool // go.partition.queues.enable
+	partitionQueuesLock   sync.Mutex
+	partitionQueues       map[topicPartitionKey]*PartitionQueue
+
 	isClosed  uint32
 	isClosing uint32
 }
@@ -107,6 +117,7 @@ func (c *Consumer) SubscribeTopics(topics []string, rebalanceCb RebalanceCb) (er
 	}
 
 	c.rebalanceCb = rebalanceCb
+	c.rebalanceListener = nil
 
 	return nil
 }
@@ -241,9 +252,10 @@ func (c *Consumer) AssignmentLost() bool {
 
 // commit offsets for specified offsets.
 // If offsets is nil the currently assigned partitions' offsets are committed.
-// This is a blocking call, caller will need to wrap in go-routine to
+// This is a blocking call that waits for the commit result or for ctx to be
+// done, whichever happens first, caller will need to wrap in go-routine to
 // get async or throw-away behaviour.
-func (c *Consumer) commit(offsets []TopicPartition) (committedOffsets []TopicPartition, err error) {
+func (c *Consumer) commit(ctx context.Context, offsets []TopicPartition) (committedOffsets []TopicPartition, err error) {
 	var rkqu *C.rd_kafka_queue_t
 
 	rkqu = C.rd_kafka_queue_new(c.handle.rk)
@@ -260,10 +272,17 @@ func (c *Consumer) commit(offsets []TopicPartition) (committedOffsets []TopicPar
 		return nil, newError(cErr)
 	}
 
-	rkev := C.rd_kafka_queue_poll(rkqu, C.int(-1))
-	if rkev == nil {
-		// shouldn't happen
-		return nil, newError(C.RD_KAFKA_RESP_ERR__DESTROY)
+	stop := c.handle.yieldOnDone(ctx, rkqu)
+	defer stop()
+
+	var rkev *C.rd_kafka_event_t
+	for rkev == nil {
+		if err = ctx.Err(); err != nil {
+			// The commit result, once available, is destroyed
+			// along with rkqu.
+			return nil, err
+		}
+		rkev = C.rd_kafka_queue_poll(rkqu, cTimeoutFromContext(ctx))
 	}
 	defer C.rd_kafka_event_destroy(rkev)
 
@@ -291,17 +310,35 @@ func (c *Consumer) commit(offsets []TopicPartition) (committedOffsets []TopicPar
 // This is a blocking call.
 // Returns the committed offsets on success.
 func (c *Consumer) Commit() ([]TopicPartition, error) {
+	return c.CommitContext(context.Background())
+}
+
+// CommitContext commits offsets for currently assigned partitions.
+// This is a blocking call that returns when the commit has completed
+// or `ctx` is done, whichever happens first.
+// Returns the committed offsets on success, or ctx.Err() if `ctx` is done
+// before the commit completes, in which case the commit may still
+// succeed in the background.
+func (c *Consumer) CommitContext(ctx context.Context) ([]TopicPartition, error) {
 	err := c.verifyClient()
 	if err != nil {
 		return nil, err
 	}
-	return c.commit(nil)
+	return c.commit(ctx, nil)
 }
 
 // CommitMessage commits offset based on the provided message.
 // This is a blocking call.
 // Returns the committed offsets on success.
 func (c *Consumer) CommitMessage(m *Message) ([]TopicPartition, error) {
+	return c.CommitMessageContext(context.Background(), m)
+}
+
+// CommitMessageContext commits offset based on the provided message.
+// This is a blocking call that returns when the commit has completed
+// or `ctx` is done, see CommitContext().
+// Returns the committed offsets on success.
+func (c *Consumer) CommitMessageContext(ctx context.Context, m *Message) ([]TopicPartition, error) {
 	err := c.verifyClient()
 	if err != nil {
 		return nil, err
@@ -311,18 +348,26 @@ func (c *Consumer) CommitMessage(m *Message) ([]TopicPartition, error) {
 	}
 	offsets := []TopicPartition{m.TopicPartition}
 	offsets[0].Offset++
-	return c.commit(offsets)
+	return c.commit(ctx, offsets)
 }
 
 // CommitOffsets commits the provided list of offsets
 // This is a blocking call.
 // Returns the committed offsets on success.
 func (c *Consumer) CommitOffsets(offsets []TopicPartition) ([]TopicPartition, error) {
+	return c.CommitOffsetsContext(context.Background(), offsets)
+}
+
+// CommitOffsetsContext commits the provided list of offsets.
+// This is a blocking call that returns when the commit has completed
+// or `ctx` is done, see CommitContext().
+// Returns the committed offsets on success.
+func (c *Consumer) CommitOffsetsContext(ctx context.Context, offsets []TopicPartition) ([]TopicPartition, error) {
 	err := c.verifyClient()
 	if err != nil {
 		return nil, err
 	}
-	return c.commit(offsets)
+	return c.commit(ctx, offsets)
 }
 
 // StoreOffsets stores the provided list of offsets that will be committed
@@ -452,6 +497,38 @@ func (c *Consumer) Poll(timeoutMs int) (event Event) {
 	return ev
 }
 
+// PollContext polls the consumer for messages or events.
+//
+// Will block until an Event is available or `ctx` is done, whichever
+// happens first.
+//
+// The following callbacks may be triggered:
+//
+//	Subscribe()'s rebalanceCb
+//
+// Returns (nil, ctx.Err()) if `ctx` is cancelled or its deadline is exceeded,
+// else (Event, nil).
+func (c *Consumer) PollContext(ctx context.Context) (Event, error) {
+	err := c.verifyClient()
+	if err != nil {
+		return nil, err
+	}
+
+	stop := c.handle.yieldOnDone(ctx, c.handle.rkq)
+	defer stop()
+
+	for {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		ev, _ := c.handle.eventPoll(nil, int(cTimeoutFromContext(ctx)), 1, nil)
+		if ev != nil {
+			return ev, nil
+		}
+	}
+}
+
 // Events returns the Events channel (if enabled)
 //
 // Deprecated: Events (channel based consumer) is deprecated in favour
@@ -526,6 +603,142 @@ func (c *Consumer) ReadMessage(timeout time.Duration) (*Message, error) {
 
 }
 
+// ReadMessageContext polls the consumer for a message.
+//
+// This is the context-aware equivalent of ReadMessage(): the call will
+// block until a new message or error is available or `ctx` is done,
+// whichever happens first.
+//
+// Cancellation or deadline expiry is returned as (nil, ctx.Err()).
+//
+// Messages are returned as (msg, nil),
+// while general errors are returned as (nil, err),
+// and partition-specific errors are returned as (msg, err) where
+// msg.TopicPartition provides partition-specific information (such as topic, partition and offset).
+//
+// All other event types, such as PartitionEOF, AssignedPartitions, etc, are silently discarded.
+func (c *Consumer) ReadMessageContext(ctx context.Context) (*Message, error) {
+	err := c.verifyClient()
+	if err != nil {
+		return nil, err
+	}
+
+	stop := c.handle.yieldOnDone(ctx, c.handle.rkq)
+	defer stop()
+
+	for {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		ev, _ := c.handle.eventPoll(nil, int(cTimeoutFromContext(ctx)), 1, nil)
+
+		switch e := ev.(type) {
+		case *Message:
+			if e.TopicPartition.Error != nil {
+				return e, e.TopicPartition.Error
+			}
+			return e, nil
+		case Error:
+			return nil, e
+		default:
+			// Ignore other event types
+		}
+	}
+}
+
+// ReadBatch polls the consumer for up to maxMessages messages.
+//
+// All messages readily available on the consumer queue are retrieved
+// with a single call into librdkafka, avoiding the per-message overhead
+// of Poll() and ReadMessage() for high-throughput consumers.
+//
+// The call will block for at most `timeout` waiting for the first message,
+// or until `ctx` is done, whichever happens first, and then return
+// with the messages that are immediately available.
+// Use -1 to wait indefinitely for the first message (or until `ctx` is done).
+//
+// Messages are returned as (msgs, nil, nil), where partition-specific errors
+// are returned as messages with msg.TopicPartition.Error set.
+// A general error ends the batch and is returned as (msgs, nil, err) along
+// with the messages read before it, if any.
+// Returns (nil, nil, ErrTimedOut) if no message was available within
+// `timeout` and (nil, nil, ctx.Err()) if `ctx` is done before any message
+// was read.
+//
+// Any other event interleaved with the messages, such as Stats,
+// OAuthBearerTokenRefresh, PartitionEOF or OffsetsCommitted, also ends the
+// batch and is returned as (msgs, ev, nil), to be handled by the
+// application as when returned by Poll().
+// Rebalance events are served as for Poll(), by calling the rebalance
+// callback, if any, or by updating the assignment, unless
+// go.application.rebalance.enable is set without a rebalance callback, in
+// which case the AssignedPartitions and RevokedPartitions events are
+// returned as ev.
+func (c *Consumer) ReadBatch(ctx context.Context, maxMessages int, timeout time.Duration) ([]*Message, Event, error) {
+	err := c.verifyClient()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if maxMessages <= 0 {
+		return nil, nil, newErrorFromString(ErrInvalidArg, "maxMessages must be > 0")
+	}
+
+	stop := c.handle.yieldOnDone(ctx, c.handle.rkq)
+	defer stop()
+
+	var absTimeout time.Time
+	if timeout > 0 {
+		absTimeout = time.Now().Add(timeout)
+	}
+
+	var msgs []*Message
+
+	for {
+		if err = ctx.Err(); err != nil {
+			if len(msgs) > 0 {
+				return msgs, nil, nil
+			}
+			return nil, nil, err
+		}
+
+		// Only wait for the first message, thereafter
+		// return what is immediately available.
+		timeoutMs := 0
+		if len(msgs) == 0 {
+			timeoutMs = int(cTimeoutFromContext(ctx))
+			if timeout > 0 {
+				remainingMs := int(math.Max(0.0, time.Until(absTimeout).Seconds()*1000.0))
+				if timeoutMs < 0 || remainingMs < timeoutMs {
+					timeoutMs = remainingMs
+				}
+			} else if timeout == 0 {
+				timeoutMs = 0
+			}
+		}
+
+		batch, ev, timedOut := c.handle.eventPollBatch(timeoutMs, maxMessages-len(msgs))
+		msgs = append(msgs, batch...)
+
+		if e, ok := ev.(Error); ok {
+			return msgs, nil, e
+		}
+
+		if ev != nil {
+			return msgs, ev, nil
+		}
+
+		if len(msgs) == maxMessages || (timedOut && len(msgs) > 0) {
+			return msgs, nil, nil
+		}
+
+		if timedOut && timeout >= 0 && !time.Now().Before(absTimeout) {
+			return nil, nil, newError(C.RD_KAFKA_RESP_ERR__TIMED_OUT)
+		}
+	}
+}
+
 // Close Consumer instance.
 // The object is no longer usable after this call.
 func (c *Consumer) Close() (err error) {
@@ -556,6 +769,11 @@ func (c *Consumer) Close() (err error) {
 	// After this point, no more consumer methods may be called.
 	atomic.StoreUint32(&c.isClosed, 1)
 
+	// Tear down any remaining partition queues
+	if c.partitionQueuesEnable {
+		c.closePartitionQueues(nil)
+	}
+
 	// Destroy our queue
 	C.rd_kafka_queue_destroy(c.handle.rkq)
 	c.handle.rkq = nil
@@ -564,6 +782,8 @@ func (c *Consumer) Close() (err error) {
 
 	C.rd_kafka_destroy(c.handle.rk)
 
+	c.handle.globalCgoRelease()
+
 	return nil
 }
 
@@ -577,10 +797,18 @@ func (c *Consumer) Close() (err error) {
 //	                                     If set to true the app must handle the AssignedPartitions and
 //	                                     RevokedPartitions events and call Assign() and Unassign()
 //	                                     respectively.
+//	go.rebalance.commit.on.revoke (bool, false) - Synchronously commit the stored offsets before partitions are unassigned
+//	                                     by the consumer, e.g., with a RebalanceListener, unless the assignment was lost.
 //	go.events.channel.enable (bool, false) - [deprecated] Enable the Events() channel. Messages and events will be pushed on the Events() channel and the Poll() interface will be disabled.
 //	go.events.channel.size (int, 1000) - Events() channel size
 //	go.logs.channel.enable (bool, false) - Forward log to Logs() channel.
 //	go.logs.channel (chan kafka.LogEvent, nil) - Forward logs to application-provided channel instead of Logs(). Requires go.logs.channel.enable=true.
+//	go.logs.slog (*slog.Logger, nil) - Log to the provided logger instead of Logs(), see LogEvent.LogTo(). Mutually exclusive with go.logs.channel.enable.
+//	go.stats.cb (kafka.StatsCb, nil) - Call the provided function with each Stats event, in addition to returning the event. Used by metrics exporters.
+//	go.partition.queues.enable (bool, false) - Create a PartitionQueue for each partition assigned through a rebalance, see Consumer.PartitionQueue().
+//	                                     Messages for these partitions are then only returned by their PartitionQueue.
+//	go.consumer.interceptors ([]kafka.ConsumerInterceptor, nil) - Interceptors of the consumed messages and committed offsets, called in order, see ConsumerInterceptor.
+//	go.oauthbearer.token.provider (kafka.OAuthBearerTokenProvider, nil) - Retrieve and set the SASL/OAUTHBEARER tokens with the provider, instead of emitting OAuthBearerTokenRefresh events.
 //
 // WARNING: Due to the buffering nature of channels (and queues in general) the
 // use of the events channel risks receiving outdated events and
@@ -616,19 +844,49 @@ func NewConsumer(conf *ConfigMap) (*Consumer, error) {
 	}
 	c.appRebalanceEnable = v.(bool)
 
+	v, err = confCopy.extract("go.rebalance.commit.on.revoke", false)
+	if err != nil {
+		return nil, err
+	}
+	c.commitOnRevoke = v.(bool)
+
 	v, err = confCopy.extract("go.events.channel.enable", false)
 	if err != nil {
 		return nil, err
 	}
 	c.eventsChanEnable = v.(bool)
 
+	v, err = confCopy.extract("go.partition.queues.enable", false)
+	if err != nil {
+		return nil, err
+	}
+	c.partitionQueuesEnable = v.(bool)
+	if c.partitionQueuesEnable {
+		c.partitionQueues = make(map[topicPartitionKey]*PartitionQueue)
+	}
+
 	v, err = confCopy.extract("go.events.channel.size", 1000)
 	if err != nil {
 		return nil, err
 	}
 	eventsChanSize := v.(int)
 
-	logsChanEnable, logsChan, err := confCopy.extractLogConfig()
+	logsChanEnable, logsChan, logger, err := confCopy.extractLogConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	c.handle.statsCb, err = confCopy.extractStatsCb()
+	if err != nil {
+		return nil, err
+	}
+
+	consumerInterceptors, err := confCopy.extractConsumerInterceptors()
+	if err != nil {
+		return nil, err
+	}
+
+	tokenProvider, err := confCopy.extractOAuthBearerTokenProvider()
 	if err != nil {
 		return nil, err
 	}
@@ -642,8 +900,20 @@ func NewConsumer(conf *ConfigMap) (*Consumer, error) {
 
 	C.rd_kafka_conf_set_events(cConf, C.RD_KAFKA_EVENT_REBALANCE|C.RD_KAFKA_EVENT_OFFSET_COMMIT|C.RD_KAFKA_EVENT_STATS|C.RD_KAFKA_EVENT_ERROR|C.RD_KAFKA_EVENT_OAUTHBEARER_TOKEN_REFRESH)
 
+	if tokenProvider != nil {
+		enableSaslQueue(cConf)
+	}
+
+	err = c.handle.setupInterceptors(cConf, nil, consumerInterceptors)
+	if err != nil {
+		C.rd_kafka_conf_destroy(cConf)
+		c.handle.globalCgoRelease()
+		return nil, err
+	}
+
 	c.handle.rk = C.rd_kafka_new(C.RD_KAFKA_CONSUMER, cConf, cErrstr, 256)
 	if c.handle.rk == nil {
+		c.handle.globalCgoRelease()
 		return nil, newErrorFromCString(C.RD_KAFKA_RESP_ERR__INVALID_ARG, cErrstr)
 	}
 
@@ -658,8 +928,12 @@ func NewConsumer(conf *ConfigMap) (*Consumer, error) {
 		c.handle.rkq = C.rd_kafka_queue_get_main(c.handle.rk)
 	}
 
-	if logsChanEnable {
-		c.handle.setupLogQueue(logsChan, c.readerTermChan)
+	if logsChanEnable || logger != nil {
+		c.handle.setupLogQueue(logsChan, logger, c.readerTermChan)
+	}
+
+	if tokenProvider != nil {
+		c.handle.setupOAuthBearerTokenProvider(tokenProvider, c.readerTermChan)
 	}
 
 	if c.eventsChanEnable {
@@ -706,6 +980,19 @@ func (c *Consumer) GetMetadata(topic *string, allTopics bool, timeoutMs int) (*M
 	return getMetadata(c, topic, allTopics, timeoutMs)
 }
 
+// GetMetadataContext queries broker for cluster and topic metadata,
+// see GetMetadata().
+// The request is bounded by the `ctx` deadline, or by `socket.timeout.ms`
+// if `ctx` has none, and the call returns ctx.Err() as soon as `ctx` is
+// done.
+func (c *Consumer) GetMetadataContext(ctx context.Context, topic *string, allTopics bool) (*Metadata, error) {
+	err := c.verifyClient()
+	if err != nil {
+		return nil, err
+	}
+	return getMetadataContext(ctx, c, topic, allTopics)
+}
+
 // QueryWatermarkOffsets queries the broker for the low and high offsets for the given topic and partition.
 func (c *Consumer) QueryWatermarkOffsets(topic string, partition int32, timeoutMs int) (low, high int64, err error) {
 	err = c.verifyClient()
@@ -715,6 +1002,19 @@ func (c *Consumer) QueryWatermarkOffsets(topic string, partition int32, timeoutM
 	return queryWatermarkOffsets(c, topic, partition, timeoutMs)
 }
 
+// QueryWatermarkOffsetsContext queries the broker for the low and high
+// offsets for the given topic and partition.
+// The request is bounded by the `ctx` deadline, or by `socket.timeout.ms`
+// if `ctx` has none, and the call returns ctx.Err() as soon as `ctx` is
+// done.
+func (c *Consumer) QueryWatermarkOffsetsContext(ctx context.Context, topic string, partition int32) (low, high int64, err error) {
+	err = c.verifyClient()
+	if err != nil {
+		return -1, -1, err
+	}
+	return queryWatermarkOffsetsContext(ctx, c, topic, partition)
+}
+
 // GetWatermarkOffsets returns the cached low and high offsets for the given topic
 // and partition.  The high offset is populated on every fetch response or via calling QueryWatermarkOffsets.
 // The low offset is populated every statistics.interval.ms if that value is set.
@@ -750,6 +1050,19 @@ func (c *Consumer) OffsetsForTimes(times []TopicPartition, timeoutMs int) (offse
 	return offsetsForTimes(c, times, timeoutMs)
 }
 
+// OffsetsForTimesContext looks up offsets by timestamp for the given
+// partitions, see OffsetsForTimes().
+// The lookup is bounded by the `ctx` deadline, or by `socket.timeout.ms`
+// if `ctx` has none, and the call returns ctx.Err() as soon as `ctx` is
+// done.
+func (c *Consumer) OffsetsForTimesContext(ctx context.Context, times []TopicPartition) (offsets []TopicPartition, err error) {
+	err = c.verifyClient()
+	if err != nil {
+		return nil, err
+	}
+	return offsetsForTimesContext(ctx, c, times)
+}
+
 // Subscription returns the current subscription as set by Subscribe()
 func (c *Consumer) Subscription() (topics []string, err error) {
 	err = c.verifyClient()
@@ -800,9 +1113,38 @@ func (c *Consumer) Committed(partitions []TopicPartition, timeoutMs int) (offset
 	if err != nil {
 		return nil, err
 	}
+	return c.committed(partitions, C.int(timeoutMs))
+}
+
+// CommittedContext retrieves committed offsets for the given set of partitions.
+// The request is bounded by the `ctx` deadline, or by `socket.timeout.ms`
+// if `ctx` has none, and the call returns ctx.Err() as soon as `ctx` is
+// done.
+func (c *Consumer) CommittedContext(ctx context.Context, partitions []TopicPartition) (offsets []TopicPartition, err error) {
+	err = c.verifyClient()
+	if err != nil {
+		return nil, err
+	}
+
+	var committed []TopicPartition
+	err = c.handle.callContext(ctx, func(cTimeoutMs C.int) error {
+		var cerr error
+		committed, cerr = c.committed(partitions, cTimeoutMs)
+		return cerr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return committed, nil
+}
+
+// committed retrieves committed offsets for the given set of partitions,
+// blocking for at most cTimeoutMs.
+func (c *Consumer) committed(partitions []TopicPartition, cTimeoutMs C.int) (offsets []TopicPartition, err error) {
 	cparts := newCPartsFromTopicPartitions(partitions)
 	defer C.rd_kafka_topic_partition_list_destroy(cparts)
-	cerr := C.rd_kafka_committed(c.handle.rk, cparts, C.int(timeoutMs))
+	cerr := C.rd_kafka_committed(c.handle.rk, cparts, cTimeoutMs)
 	if cerr != C.RD_KAFKA_RESP_ERR_NO_ERROR {
 		return nil, newError(cerr)
 	}
@@ -1014,11 +1356,29 @@ func cEventToRebalanceEvent(rkev *C.rd_kafka_event_t) Event {
 //
 // In the polling case (not channel based consumer) the rebalance event
 // is returned in retval, else nil is returned.
+//
+// With go.partition.queues.enable=true the partition queues are created
+// for assigned partitions and torn down for revoked partitions before
+// the event is forwarded to the application.
 
 func (c *Consumer) handleRebalanceEvent(channel chan Event, rkev *C.rd_kafka_event_t) (retval Event) {
 
 	var ev Event
 
+	if c.partitionQueuesEnable {
+		// Set up the partition queues before the partitions are assigned,
+		// and stop their consumers before the partitions are unassigned.
+		if C.rd_kafka_event_error(rkev) == C.RD_KAFKA_RESP_ERR__ASSIGN_PARTITIONS {
+			c.openPartitionQueues(C.rd_kafka_event_topic_partition_list(rkev))
+		} else {
+			c.closePartitionQueues(C.rd_kafka_event_topic_partition_list(rkev))
+		}
+	}
+
+	if c.rebalanceListener != nil {
+		return c.handleRebalanceListener(rkev)
+	}
+
 	if c.rebalanceCb != nil || c.appRebalanceEnable {
 		// Application has a rebalance callback or has enabled
 		// rebalances on the events channel, create the appropriate Event.
@@ -1049,40 +1409,11 @@ func (c *Consumer) handleRebalanceEvent(channel chan Event, rkev *C.rd_kafka_eve
 
 	// Either there was no rebalance callback, or the application
 	// did not call *Assign / *Unassign, so we need to do it.
-
-	isCooperative := c.GetRebalanceProtocol() == "COOPERATIVE"
-	var cError *C.rd_kafka_error_t
-	var cErr C.rd_kafka_resp_err_t
-
-	if C.rd_kafka_event_error(rkev) == C.RD_KAFKA_RESP_ERR__ASSIGN_PARTITIONS {
-		// Assign partitions
-		if isCooperative {
-			cError = C.rd_kafka_incremental_assign(
-				c.handle.rk,
-				C.rd_kafka_event_topic_partition_list(rkev))
-		} else {
-			cErr = C.rd_kafka_assign(
-				c.handle.rk,
-				C.rd_kafka_event_topic_partition_list(rkev))
-		}
-	} else {
-		// Revoke partitions
-
-		if isCooperative {
-			cError = C.rd_kafka_incremental_unassign(
-				c.handle.rk,
-				C.rd_kafka_event_topic_partition_list(rkev))
-		} else {
-			cErr = C.rd_kafka_assign(c.handle.rk, nil)
-		}
-	}
-
+	//
 	// If the *assign() call returned error, forward it to the
 	// the consumer's Events() channel for visibility.
-	if cError != nil {
-		c.events <- newErrorFromCErrorDestroy(cError)
-	} else if cErr != 0 {
-		c.events <- newError(cErr)
+	if err := c.rebalanceAssign(rkev); err != nil {
+		c.events <- newRebalanceErrorEvent(err)
 	}
 
 	return nil
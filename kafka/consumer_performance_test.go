@@ -17,6 +17,7 @@
 package kafka
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"testing"
@@ -123,6 +124,28 @@ func eventPollConsumer(c *Consumer, rd *ratedisp, expCnt int) {
 	}
 }
 
+// consume messages through the ReadBatch() interface
+func readBatchConsumer(c *Consumer, rd *ratedisp, expCnt int) {
+	for true {
+		msgs, ev, err := c.ReadBatch(context.Background(), 1000, 100*time.Millisecond)
+		for _, m := range msgs {
+			if !handleEvent(c, rd, expCnt, m) {
+				return
+			}
+		}
+		if ev != nil {
+			if !handleEvent(c, rd, expCnt, ev) {
+				return
+			}
+		}
+		if err != nil && err.(Error).Code() != ErrTimedOut {
+			if !handleEvent(c, rd, expCnt, err.(Error)) {
+				return
+			}
+		}
+	}
+}
+
 var testconsumerInited = false
 
 // Produce messages to consume (if needed)
@@ -175,3 +198,8 @@ func BenchmarkConsumerPollRebalancePerformance(b *testing.B) {
 			return nil
 		})
 }
+
+func BenchmarkConsumerReadBatchPerformance(b *testing.B) {
+	consumerPerfTest(b, "ReadBatch Consumer",
+		0, false, readBatchConsumer, nil)
+}
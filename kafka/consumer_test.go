@@ -17,6 +17,7 @@
 package kafka
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"reflect"
@@ -260,6 +261,309 @@ func testConsumerAPIs(t *testing.T, c *Consumer, errCheck error) {
 	}
 }
 
+// TestConsumerContextAPIs dry-tests the context-aware Consumer APIs,
+// no broker is needed.
+func TestConsumerContextAPIs(t *testing.T) {
+	c, err := NewConsumer(&ConfigMap{
+		"group.id":          "gotest",
+		"bootstrap.servers": "127.0.0.1:65533",
+	})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	topic := "gotest"
+	err = c.Assign([]TopicPartition{{Topic: &topic, Partition: 0}})
+	if err != nil {
+		t.Fatalf("Assign() failed: %s", err)
+	}
+
+	// Cancelling the context must wake up a blocked PollContext()
+	// and ReadMessageContext() promptly.
+	for _, name := range []string{"PollContext", "ReadMessageContext"} {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(200*time.Millisecond, cancel)
+
+		start := time.Now()
+		var ev interface{}
+		if name == "PollContext" {
+			ev, err = c.PollContext(ctx)
+		} else {
+			ev, err = c.ReadMessageContext(ctx)
+		}
+		duration := time.Since(start)
+
+		t.Logf("%s() returned %v and %v in %v", name, ev, err, duration)
+		if err != context.Canceled {
+			t.Errorf("%s() should have returned %v, not %v", name, context.Canceled, err)
+		}
+		if duration > 2*time.Second {
+			t.Errorf("%s() should have returned promptly on cancellation, took %v", name, duration)
+		}
+		cancel()
+	}
+
+	// Deadline expiry
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	msg, err := c.ReadMessageContext(ctx)
+	cancel()
+	if err != context.DeadlineExceeded || msg != nil {
+		t.Errorf("ReadMessageContext() should have returned (nil, %v), not (%v, %v)",
+			context.DeadlineExceeded, msg, err)
+	}
+
+	// An already cancelled context returns immediately.
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = c.CommitContext(ctx)
+	if err != context.Canceled {
+		t.Errorf("CommitContext() should have returned %v, not %v", context.Canceled, err)
+	}
+
+	// Committed, OffsetsForTimes, QueryWatermarkOffsets and GetMetadata
+	// can't reach a broker and must return when the context is cancelled.
+	checkCancelled := func(name string, call func(ctx context.Context) error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		time.AfterFunc(200*time.Millisecond, cancel)
+
+		start := time.Now()
+		err := call(ctx)
+		duration := time.Since(start)
+		t.Logf("%s() returned %v in %v", name, err, duration)
+		if err != context.Canceled {
+			t.Errorf("%s() should have returned %v, not %v", name, context.Canceled, err)
+		}
+		if duration > 2*time.Second {
+			t.Errorf("%s() should have returned promptly on cancellation, took %v", name, duration)
+		}
+	}
+
+	checkCancelled("CommittedContext", func(ctx context.Context) error {
+		_, err := c.CommittedContext(ctx, []TopicPartition{{Topic: &topic, Partition: 0}})
+		return err
+	})
+	checkCancelled("OffsetsForTimesContext", func(ctx context.Context) error {
+		_, err := c.OffsetsForTimesContext(ctx, []TopicPartition{{Topic: &topic, Offset: 12345}})
+		return err
+	})
+	checkCancelled("QueryWatermarkOffsetsContext", func(ctx context.Context) error {
+		_, _, err := c.QueryWatermarkOffsetsContext(ctx, topic, 0)
+		return err
+	})
+	checkCancelled("GetMetadataContext", func(ctx context.Context) error {
+		_, err := c.GetMetadataContext(ctx, &topic, false)
+		return err
+	})
+
+	err = c.Close()
+	if err != nil {
+		t.Errorf("Close() failed: %s", err)
+	}
+
+	_, err = c.PollContext(context.Background())
+	if err != getOperationNotAllowedErrorForClosedClient() {
+		t.Errorf("PollContext() on closed consumer should have failed, got %v", err)
+	}
+}
+
+// TestConsumerContextCancelClose tests that Close() does not hang after
+// blocking calls were cancelled with a context without a deadline,
+// no broker is needed.
+func TestConsumerContextCancelClose(t *testing.T) {
+	c, err := NewConsumer(&ConfigMap{
+		"group.id":          "gotest",
+		"bootstrap.servers": "127.0.0.1:65533",
+		"socket.timeout.ms": 1000,
+	})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	topic := "gotest"
+	calls := map[string]func(ctx context.Context) error{
+		"CommittedContext": func(ctx context.Context) error {
+			_, err := c.CommittedContext(ctx, []TopicPartition{{Topic: &topic, Partition: 0}})
+			return err
+		},
+		"OffsetsForTimesContext": func(ctx context.Context) error {
+			_, err := c.OffsetsForTimesContext(ctx, []TopicPartition{{Topic: &topic, Offset: 12345}})
+			return err
+		},
+		"QueryWatermarkOffsetsContext": func(ctx context.Context) error {
+			_, _, err := c.QueryWatermarkOffsetsContext(ctx, topic, 0)
+			return err
+		},
+		"GetMetadataContext": func(ctx context.Context) error {
+			_, err := c.GetMetadataContext(ctx, &topic, false)
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(100*time.Millisecond, cancel)
+		if err := call(ctx); err != context.Canceled {
+			t.Errorf("%s() should have returned %v, not %v", name, context.Canceled, err)
+		}
+	}
+
+	// The cancelled calls keep running in the background, bounded by
+	// socket.timeout.ms, and Close() waits for them.
+	closed := make(chan error, 1)
+	go func() {
+		closed <- c.Close()
+	}()
+
+	select {
+	case err = <-closed:
+		if err != nil {
+			t.Errorf("Close() failed: %s", err)
+		}
+	case <-time.After(30 * time.Second):
+		t.Fatalf("Close() did not return after the cancelled calls")
+	}
+}
+
+// TestConsumerReadBatch tests ReadBatch() timeouts and cancellation
+// without a broker.
+func TestConsumerReadBatch(t *testing.T) {
+	c, err := NewConsumer(&ConfigMap{
+		"group.id":          "gotest",
+		"bootstrap.servers": "127.0.0.1:65533",
+	})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	_, _, err = c.ReadBatch(context.Background(), 0, time.Second)
+	if err == nil || err.(Error).Code() != ErrInvalidArg {
+		t.Errorf("ReadBatch() with maxMessages 0 should have failed with ErrInvalidArg, got %v", err)
+	}
+
+	start := time.Now()
+	msgs, ev, err := c.ReadBatch(context.Background(), 100, 200*time.Millisecond)
+	duration := time.Since(start)
+	if msgs != nil || ev != nil || err == nil || err.(Error).Code() != ErrTimedOut {
+		t.Errorf("ReadBatch() should have returned (nil, nil, ErrTimedOut), not (%v, %v, %v)", msgs, ev, err)
+	}
+	if duration > 2*time.Second {
+		t.Errorf("ReadBatch() should have timed out after 200ms, took %v", duration)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(200*time.Millisecond, cancel)
+	start = time.Now()
+	msgs, ev, err = c.ReadBatch(ctx, 100, -1)
+	duration = time.Since(start)
+	if msgs != nil || ev != nil || err != context.Canceled {
+		t.Errorf("ReadBatch() should have returned (nil, nil, %v), not (%v, %v, %v)", context.Canceled, msgs, ev, err)
+	}
+	if duration > 2*time.Second {
+		t.Errorf("ReadBatch() should have returned promptly on cancellation, took %v", duration)
+	}
+
+	err = c.Close()
+	if err != nil {
+		t.Errorf("Close() failed: %s", err)
+	}
+
+	_, _, err = c.ReadBatch(context.Background(), 100, time.Second)
+	if err != getOperationNotAllowedErrorForClosedClient() {
+		t.Errorf("ReadBatch() on closed consumer should have failed, got %v", err)
+	}
+}
+
+// TestConsumerReadBatchEvents tests that ReadBatch() returns the events
+// interleaved with the messages, without a broker.
+func TestConsumerReadBatchEvents(t *testing.T) {
+	c, err := NewConsumer(&ConfigMap{
+		"group.id":                "gotest",
+		"bootstrap.servers":       "127.0.0.1:65533",
+		"statistics.interval.ms":  50,
+		"security.protocol":       "SASL_PLAINTEXT",
+		"sasl.mechanisms":         "OAUTHBEARER",
+		"sasl.oauthbearer.config": "principal=gotest",
+	})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	defer c.Close()
+
+	gotStats := false
+	gotRefresh := false
+	deadline := time.Now().Add(10 * time.Second)
+	for (!gotStats || !gotRefresh) && time.Now().Before(deadline) {
+		msgs, ev, err := c.ReadBatch(context.Background(), 100, 100*time.Millisecond)
+		if len(msgs) > 0 {
+			t.Errorf("Unexpected messages %v", msgs)
+		}
+		if err != nil && err.(Error).Code() != ErrTimedOut {
+			t.Logf("Ignoring error %v", err)
+		}
+
+		switch e := ev.(type) {
+		case nil:
+		case *Stats:
+			gotStats = true
+		case OAuthBearerTokenRefresh:
+			gotRefresh = true
+			if e.Config != "principal=gotest" {
+				t.Errorf("Expected config principal=gotest, not %s", e.Config)
+			}
+			c.SetOAuthBearerTokenFailure("No token")
+		default:
+			t.Logf("Ignoring event %v", ev)
+		}
+	}
+
+	if !gotStats {
+		t.Errorf("ReadBatch() should have returned a Stats event")
+	}
+	if !gotRefresh {
+		t.Errorf("ReadBatch() should have returned an OAuthBearerTokenRefresh event")
+	}
+}
+
+// TestConsumerPartitionQueue tests PartitionQueue() argument validation
+func TestConsumerPartitionQueue(t *testing.T) {
+	topic := "gotest"
+	tp := TopicPartition{Topic: &topic, Partition: 0}
+
+	c, err := NewConsumer(&ConfigMap{
+		"group.id":          "gotest",
+		"bootstrap.servers": "127.0.0.1:65533",
+	})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	_, err = c.PartitionQueue(tp)
+	if err == nil || err.(Error).Code() != ErrInvalidArg {
+		t.Errorf("PartitionQueue() without go.partition.queues.enable should have failed with ErrInvalidArg, got %v", err)
+	}
+	c.Close()
+
+	c, err = NewConsumer(&ConfigMap{
+		"group.id":                   "gotest",
+		"bootstrap.servers":          "127.0.0.1:65533",
+		"go.partition.queues.enable": true,
+	})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	_, err = c.PartitionQueue(tp)
+	if err == nil || err.(Error).Code() != ErrUnknownPartition {
+		t.Errorf("PartitionQueue() of unassigned partition should have failed with ErrUnknownPartition, got %v", err)
+	}
+
+	err = c.Close()
+	if err != nil {
+		t.Errorf("Close() failed: %s", err)
+	}
+}
+
 func TestConsumerSubscription(t *testing.T) {
 	c, err := NewConsumer(&ConfigMap{"group.id": "gotest"})
 	if err != nil {
@@ -0,0 +1,219 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kafka
+
+import (
+	"context"
+	"sync"
+)
+
+// deliveryReportsChanSize is the size of the channel of the delivery
+// reports of ProduceAsync() messages.
+const deliveryReportsChanSize = 1000
+
+// DeliveryFuture is the pending delivery of a message produced with
+// ProduceAsync().
+type DeliveryFuture struct {
+	id     uint64
+	opaque interface{}
+	done   chan struct{}
+	msg    *Message
+	err    error
+}
+
+// Done returns a channel that is closed once the delivery report is
+// received.
+func (f *DeliveryFuture) Done() <-chan struct{} {
+	return f.done
+}
+
+// Result returns the delivered message and its delivery error, once Done().
+//
+// Returns ErrState if the delivery report has not been received yet.
+// If the producer was closed before the delivery report was received the
+// error is ErrDestroy and the message is nil.
+func (f *DeliveryFuture) Result() (*Message, error) {
+	select {
+	case <-f.done:
+		return f.msg, f.err
+	default:
+		return nil, newErrorFromString(ErrState, "Delivery report not received yet")
+	}
+}
+
+// Wait waits for the delivery report, or for ctx to be done.
+//
+// Returns the delivered message and its delivery error, as Result(),
+// or ctx.Err(). Wait may be called again after ctx is done.
+func (f *DeliveryFuture) Wait(ctx context.Context) (*Message, error) {
+	select {
+	case <-f.done:
+		return f.msg, f.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// complete sets the result of the delivery.
+func (f *DeliveryFuture) complete(msg *Message, err error) {
+	f.msg = msg
+	f.err = err
+	close(f.done)
+}
+
+// deliveryID is the Opaque of a message produced with ProduceAsync(),
+// identifying its DeliveryFuture.
+type deliveryID uint64
+
+// deliveryDispatcher dispatches the delivery reports of the messages
+// produced with ProduceAsync() to their DeliveryFuture, by Opaque.
+type deliveryDispatcher struct {
+	// reports is the delivery channel of all ProduceAsync() messages
+	reports chan Event
+	done    chan struct{}
+
+	lock    sync.Mutex
+	nextID  uint64
+	pending map[uint64]*DeliveryFuture
+}
+
+func newDeliveryDispatcher() *deliveryDispatcher {
+	return &deliveryDispatcher{
+		reports: make(chan Event, deliveryReportsChanSize),
+		done:    make(chan struct{}),
+		pending: make(map[uint64]*DeliveryFuture),
+	}
+}
+
+// add registers a new DeliveryFuture for a message with opaque.
+func (d *deliveryDispatcher) add(opaque interface{}) *DeliveryFuture {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.nextID++
+	f := &DeliveryFuture{id: d.nextID, opaque: opaque, done: make(chan struct{})}
+	d.pending[f.id] = f
+	return f
+}
+
+// remove unregisters f, whose delivery report is then ignored.
+func (d *deliveryDispatcher) remove(f *DeliveryFuture) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	delete(d.pending, f.id)
+}
+
+// take unregisters and returns the DeliveryFuture of id, if any.
+func (d *deliveryDispatcher) take(id uint64) (*DeliveryFuture, bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	f, found := d.pending[id]
+	if found {
+		delete(d.pending, id)
+	}
+	return f, found
+}
+
+// run dispatches delivery reports until reports is closed, then fails the
+// pending deliveries with ErrDestroy.
+func (d *deliveryDispatcher) run() {
+	defer close(d.done)
+
+	for ev := range d.reports {
+		msg, ok := ev.(*Message)
+		if !ok {
+			continue
+		}
+		id, ok := msg.Opaque.(deliveryID)
+		if !ok {
+			continue
+		}
+
+		f, found := d.take(uint64(id))
+		if !found {
+			// The delivery was cancelled
+			continue
+		}
+
+		msg.Opaque = f.opaque
+		f.complete(msg, msg.TopicPartition.Error)
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	for id, f := range d.pending {
+		f.complete(nil, newErrorFromString(ErrDestroy,
+			"Producer closed before the delivery report was received"))
+		delete(d.pending, id)
+	}
+}
+
+// close stops the dispatcher once no more delivery reports can be received.
+func (d *deliveryDispatcher) close() {
+	close(d.reports)
+	<-d.done
+}
+
+// ProduceAsync produces a single message, as Produce(), and returns the
+// DeliveryFuture of its delivery report.
+//
+// The delivery report is not emitted on the Events() channel.
+// The message's Opaque is restored in the delivered message.
+//
+// Returns the DeliveryFuture, or an error if the message could not be
+// enqueued.
+func (p *Producer) ProduceAsync(msg *Message) (*DeliveryFuture, error) {
+	err := p.verifyClient()
+	if err != nil {
+		return nil, err
+	}
+	if msg == nil {
+		return nil, newErrorFromString(ErrInvalidArg, "")
+	}
+
+	f := p.deliveries.add(msg.Opaque)
+
+	m := *msg
+	m.Opaque = deliveryID(f.id)
+	err = p.produce(&m, 0, p.deliveries.reports)
+	if err != nil {
+		p.deliveries.remove(f)
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// ProduceSync produces a single message, as Produce(), and waits for its
+// delivery report, or for ctx to be done.
+//
+// Returns the delivered message, or the message's delivery error, an
+// error if the message could not be enqueued, or ctx.Err().
+// If ctx is done first, the delivery report is discarded: the message may
+// still be delivered.
+func (p *Producer) ProduceSync(ctx context.Context, msg *Message) (*Message, error) {
+	f, err := p.ProduceAsync(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := f.Wait(ctx)
+	if err != nil && err == ctx.Err() {
+		p.deliveries.remove(f)
+	}
+	return m, err
+}
@@ -0,0 +1,425 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package eos provides an exactly-once consume-transform-produce processor.
+//
+// A Processor consumes input messages with a read_committed kafka.Consumer,
+// transforms each of them into zero or more output messages, and produces
+// the output messages with a transactional kafka.Producer.
+// Input messages are batched into transactions, which also commit the
+// consumer offsets of the batch with SendOffsetsToTransaction(): the
+// output messages and the input offsets are committed atomically.
+//
+// When a transaction is aborted, the consumer is rewound to the first
+// message of the transaction so that the batch is processed again.
+package eos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// Transform transforms an input message into zero or more output messages.
+//
+// The output messages must have a topic, and kafka.PartitionAny as
+// partition unless a partition is chosen.
+// A returned error aborts the ongoing transaction and stops the Processor.
+type Transform func(ctx context.Context, msg *kafka.Message) ([]*kafka.Message, error)
+
+// Config holds the Processor configuration.
+type Config struct {
+	// BatchSize is the maximum number of input messages per transaction
+	// (default 100).
+	BatchSize int
+	// BatchTimeout is the maximum time a transaction stays open before it
+	// is committed (default 100ms).
+	BatchTimeout time.Duration
+	// PollTimeout is the maximum consumer poll timeout (default 100ms).
+	PollTimeout time.Duration
+	// TransactionTimeout is the maximum time to initialize, commit or abort
+	// a transaction, including retries (default 30s).
+	TransactionTimeout time.Duration
+	// ErrorCb is called with non-fatal consumer and producer errors, and
+	// the errors of aborted transactions, if set.
+	// It may be called from any goroutine.
+	ErrorCb func(err error)
+}
+
+// partitionKey identifies an input partition
+type partitionKey struct {
+	topic     string
+	partition int32
+}
+
+// Processor is an exactly-once consume-transform-produce processor.
+type Processor struct {
+	c         *kafka.Consumer
+	p         *kafka.Producer
+	transform Transform
+	config    Config
+
+	initialized bool
+	eventsDone  chan struct{}
+
+	// State of the ongoing transaction
+	inTransaction bool
+	txnStart      time.Time
+	txnMessages   int
+	// first is the first input message of each partition in the
+	// transaction, to rewind to if it is aborted.
+	first map[partitionKey]kafka.TopicPartition
+	// next is the offset to commit for each partition in the transaction.
+	next map[partitionKey]kafka.TopicPartition
+}
+
+// NewProcessor creates a new Processor, transforming messages with
+// transform, with a consumer configured by consumerConfig and a
+// transactional producer configured by producerConfig.
+//
+// consumerConfig must have a `group.id`: the consumer is configured with
+// `isolation.level=read_committed` and `enable.auto.commit=false`.
+// producerConfig must have a `transactional.id`, unique to this Processor
+// instance.
+//
+// The Processor owns the consumer and the producer: Close() the Processor
+// when done.
+func NewProcessor(consumerConfig, producerConfig *kafka.ConfigMap, transform Transform, config Config) (*Processor, error) {
+	if consumerConfig == nil || producerConfig == nil || transform == nil {
+		return nil, kafka.NewError(kafka.ErrInvalidArg,
+			"Consumer config, producer config and transform are required", false)
+	}
+
+	if txnID, _ := producerConfig.Get("transactional.id", ""); txnID == "" {
+		return nil, kafka.NewError(kafka.ErrInvalidArg,
+			"Producer config must have a transactional.id", false)
+	}
+
+	if config.BatchSize < 0 || config.BatchTimeout < 0 || config.PollTimeout < 0 ||
+		config.TransactionTimeout < 0 {
+		return nil, kafka.NewError(kafka.ErrInvalidArg,
+			"Config values must not be negative", false)
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = 100
+	}
+	if config.BatchTimeout == 0 {
+		config.BatchTimeout = 100 * time.Millisecond
+	}
+	if config.PollTimeout == 0 {
+		config.PollTimeout = 100 * time.Millisecond
+	}
+	if config.TransactionTimeout == 0 {
+		config.TransactionTimeout = 30 * time.Second
+	}
+
+	cConfig := kafka.ConfigMap{}
+	for key, value := range *consumerConfig {
+		cConfig[key] = value
+	}
+	cConfig["isolation.level"] = "read_committed"
+	cConfig["enable.auto.commit"] = false
+
+	c, err := kafka.NewConsumer(&cConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := kafka.NewProducer(producerConfig)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	e := &Processor{
+		c:          c,
+		p:          p,
+		transform:  transform,
+		config:     config,
+		eventsDone: make(chan struct{}),
+		first:      make(map[partitionKey]kafka.TopicPartition),
+		next:       make(map[partitionKey]kafka.TopicPartition),
+	}
+
+	go e.serveProducerEvents()
+
+	return e, nil
+}
+
+// String returns a human readable name for a Processor instance
+func (e *Processor) String() string {
+	return fmt.Sprintf("EOSProcessor(%s, %s)", e.c, e.p)
+}
+
+// Consumer returns the Processor's consumer.
+func (e *Processor) Consumer() *kafka.Consumer {
+	return e.c
+}
+
+// Producer returns the Processor's transactional producer.
+func (e *Processor) Producer() *kafka.Producer {
+	return e.p
+}
+
+// Close closes the Processor's consumer and producer.
+// Run() must have returned.
+func (e *Processor) Close() error {
+	err := e.c.Close()
+	e.p.Close()
+	<-e.eventsDone
+	return err
+}
+
+// Run subscribes the consumer to topics and processes messages until ctx is
+// done or an error occurs.
+//
+// The ongoing transaction is committed when ctx is done.
+//
+// Returns ctx.Err(), the error of the transform, or a fatal error, in which
+// case the Processor must be closed.
+func (e *Processor) Run(ctx context.Context, topics []string) error {
+	if !e.initialized {
+		txnCtx, cancel := context.WithTimeout(ctx, e.config.TransactionTimeout)
+		err := e.p.InitTransactions(txnCtx)
+		cancel()
+		if err != nil {
+			return err
+		}
+		e.initialized = true
+	}
+
+	err := e.c.SubscribeTopics(topics, e.rebalance)
+	if err != nil {
+		return err
+	}
+
+	for ctx.Err() == nil {
+		if e.inTransaction && (e.txnMessages >= e.config.BatchSize ||
+			time.Since(e.txnStart) >= e.config.BatchTimeout) {
+			err = e.commit()
+			if err != nil {
+				return err
+			}
+		}
+
+		switch ev := e.c.Poll(e.pollTimeoutMs()).(type) {
+		case *kafka.Message:
+			if ev.TopicPartition.Error != nil {
+				e.onError(ev.TopicPartition.Error)
+				continue
+			}
+			err = e.process(ctx, ev)
+			if err != nil {
+				return err
+			}
+
+		case kafka.Error:
+			if ev.IsFatal() {
+				return ev
+			}
+			e.onError(ev)
+		}
+	}
+
+	if e.inTransaction {
+		err = e.commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return ctx.Err()
+}
+
+// process transforms msg and produces the output messages in the ongoing
+// transaction, which is begun if needed.
+func (e *Processor) process(ctx context.Context, msg *kafka.Message) error {
+	if !e.inTransaction {
+		err := e.p.BeginTransaction()
+		if err != nil {
+			return err
+		}
+		e.inTransaction = true
+		e.txnStart = time.Now()
+		e.txnMessages = 0
+	}
+
+	tp := msg.TopicPartition
+	key := partitionKey{*tp.Topic, tp.Partition}
+	if _, found := e.first[key]; !found {
+		e.first[key] = kafka.TopicPartition{Topic: tp.Topic, Partition: tp.Partition,
+			Offset: tp.Offset, LeaderEpoch: tp.LeaderEpoch}
+	}
+	e.next[key] = kafka.TopicPartition{Topic: tp.Topic, Partition: tp.Partition,
+		Offset: tp.Offset + 1, LeaderEpoch: tp.LeaderEpoch}
+	e.txnMessages++
+
+	outputs, err := e.transform(ctx, msg)
+	if err == nil {
+		for _, out := range outputs {
+			err = e.p.Produce(out, nil)
+			if err != nil {
+				break
+			}
+		}
+	}
+
+	if err != nil {
+		abortErr := e.abort(nil)
+		if abortErr != nil {
+			return abortErr
+		}
+		return err
+	}
+
+	return nil
+}
+
+// commit sends the offsets of the ongoing transaction and commits it.
+// Abortable errors abort the transaction and are reported to ErrorCb.
+//
+// Returns fatal or unexpected errors.
+func (e *Processor) commit() error {
+	ctx, cancel := context.WithTimeout(context.Background(), e.config.TransactionTimeout)
+	defer cancel()
+
+	consumerMetadata, err := e.c.GetConsumerGroupMetadata()
+	if err != nil {
+		return err
+	}
+
+	offsets := make([]kafka.TopicPartition, 0, len(e.next))
+	for _, tp := range e.next {
+		offsets = append(offsets, tp)
+	}
+
+	err = e.p.RetryTransactional(ctx, func() error {
+		return e.p.SendOffsetsToTransaction(ctx, offsets, consumerMetadata)
+	})
+	if err == nil {
+		err = e.p.RetryTransactional(ctx, func() error {
+			return e.p.CommitTransaction(ctx)
+		})
+	}
+
+	if err != nil {
+		if kerr, ok := err.(kafka.Error); ok && kerr.TxnRequiresAbort() {
+			e.onError(err)
+			return e.abort(nil)
+		}
+		return err
+	}
+
+	e.endTransaction()
+	return nil
+}
+
+// abort aborts the ongoing transaction and rewinds the consumer to the
+// first message of the transaction of each partition, except the revoked
+// partitions.
+func (e *Processor) abort(revoked map[partitionKey]bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), e.config.TransactionTimeout)
+	defer cancel()
+
+	err := e.p.RetryTransactional(ctx, func() error {
+		return e.p.AbortTransaction(ctx)
+	})
+	if err != nil {
+		return err
+	}
+
+	for key, tp := range e.first {
+		if revoked[key] {
+			continue
+		}
+		err = e.c.Seek(tp, -1)
+		if err != nil {
+			return err
+		}
+	}
+
+	e.endTransaction()
+	return nil
+}
+
+// endTransaction resets the state of the ongoing transaction.
+func (e *Processor) endTransaction() {
+	e.inTransaction = false
+	e.first = make(map[partitionKey]kafka.TopicPartition)
+	e.next = make(map[partitionKey]kafka.TopicPartition)
+}
+
+// pollTimeoutMs returns the poll timeout, bounded by the time the ongoing
+// transaction is due to be committed.
+func (e *Processor) pollTimeoutMs() int {
+	timeout := e.config.PollTimeout
+	if e.inTransaction {
+		if d := time.Until(e.txnStart.Add(e.config.BatchTimeout)); d < timeout {
+			timeout = d
+		}
+	}
+	if timeout < 0 {
+		timeout = 0
+	}
+	return int(timeout / time.Millisecond)
+}
+
+// rebalance is the consumer's rebalance callback.
+func (e *Processor) rebalance(c *kafka.Consumer, ev kafka.Event) error {
+	if rev, ok := ev.(kafka.RevokedPartitions); ok && e.inTransaction {
+		// The offsets of revoked partitions can no longer be committed
+		// by this consumer: the ongoing transaction is aborted and the
+		// partitions that remain assigned are rewound.
+		revoked := make(map[partitionKey]bool, len(rev.Partitions))
+		for _, tp := range rev.Partitions {
+			revoked[partitionKey{*tp.Topic, tp.Partition}] = true
+		}
+
+		err := e.abort(revoked)
+		if err != nil {
+			e.onError(err)
+		}
+	}
+
+	// Let the consumer perform the (incremental) assign or unassign.
+	return nil
+}
+
+// serveProducerEvents serves the producer's events channel until the
+// producer is closed, reporting errors to ErrorCb.
+// Delivery failures make the transaction's commit fail.
+func (e *Processor) serveProducerEvents() {
+	defer close(e.eventsDone)
+	for ev := range e.p.Events() {
+		switch ev := ev.(type) {
+		case *kafka.Message:
+			if ev.TopicPartition.Error != nil {
+				e.onError(ev.TopicPartition.Error)
+			}
+		case kafka.Error:
+			e.onError(ev)
+		}
+	}
+}
+
+// onError reports a non-fatal error to the application's ErrorCb, if any.
+func (e *Processor) onError(err error) {
+	if e.config.ErrorCb != nil {
+		e.config.ErrorCb(err)
+	}
+}
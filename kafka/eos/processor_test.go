@@ -0,0 +1,197 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package eos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProcessor(t *testing.T) {
+	assert := assert.New(t)
+
+	transform := func(ctx context.Context, msg *kafka.Message) ([]*kafka.Message, error) {
+		return nil, nil
+	}
+
+	_, err := NewProcessor(&kafka.ConfigMap{"group.id": "eos"}, &kafka.ConfigMap{}, transform, Config{})
+	assert.Error(err, "A transactional.id should be required")
+
+	_, err = NewProcessor(&kafka.ConfigMap{"group.id": "eos"},
+		&kafka.ConfigMap{"transactional.id": "eos"}, nil, Config{})
+	assert.Error(err, "A transform should be required")
+
+	_, err = NewProcessor(&kafka.ConfigMap{"group.id": "eos"},
+		&kafka.ConfigMap{"transactional.id": "eos"}, transform, Config{BatchSize: -1})
+	assert.Error(err, "Negative config values should fail")
+
+	consumerConfig := &kafka.ConfigMap{"group.id": "eos"}
+	e, err := NewProcessor(consumerConfig,
+		&kafka.ConfigMap{"transactional.id": "eos"}, transform, Config{})
+	assert.NoError(err)
+	assert.Equal(100, e.config.BatchSize)
+	assert.Equal(100*time.Millisecond, e.config.BatchTimeout)
+	_, found := (*consumerConfig)["isolation.level"]
+	assert.False(found, "The consumer config should not be modified")
+	assert.NoError(e.Close())
+}
+
+// TestProcessorExactlyOnce tests that each input message is transformed
+// into output messages exactly once, across a failed Processor and the
+// Processor resuming from its committed offsets.
+func TestProcessorExactlyOnce(t *testing.T) {
+	assert := assert.New(t)
+
+	mockCluster, err := kafka.NewMockCluster(3)
+	assert.NoError(err, "Mock cluster creation should succeed")
+	defer mockCluster.Close()
+
+	input := "input"
+	output := "output"
+	assert.NoError(mockCluster.CreateTopic(input, 2, 3))
+	assert.NoError(mockCluster.CreateTopic(output, 2, 3))
+
+	p, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+	})
+	assert.NoError(err, "Producer creation should succeed")
+	defer p.Close()
+
+	msgcnt := 50
+	var expected []string
+	for i := 0; i < msgcnt; i++ {
+		err = p.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &input, Partition: int32(i % 2)},
+			Value:          []byte(strconv.Itoa(i)),
+		}, nil)
+		assert.NoError(err)
+		// Multiples of 5 are filtered out, multiples of 7 duplicated.
+		if i%5 != 0 {
+			expected = append(expected, fmt.Sprintf("out-%d", i))
+			if i%7 == 0 {
+				expected = append(expected, fmt.Sprintf("out-%d", i))
+			}
+		}
+	}
+	assert.Zero(p.Flush(10 * 1000))
+	sort.Strings(expected)
+
+	transform := func(failAt int) Transform {
+		return func(ctx context.Context, msg *kafka.Message) ([]*kafka.Message, error) {
+			i, err := strconv.Atoi(string(msg.Value))
+			if err != nil {
+				return nil, err
+			}
+			if i == failAt {
+				return nil, errors.New("transform failure")
+			}
+			if i%5 == 0 {
+				return nil, nil
+			}
+			out := &kafka.Message{
+				TopicPartition: kafka.TopicPartition{Topic: &output, Partition: kafka.PartitionAny},
+				Value:          []byte(fmt.Sprintf("out-%d", i)),
+			}
+			if i%7 == 0 {
+				return []*kafka.Message{out, out}, nil
+			}
+			return []*kafka.Message{out}, nil
+		}
+	}
+
+	consumerConfig := &kafka.ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+		"group.id":          "eos",
+		"auto.offset.reset": "earliest",
+	}
+	producerConfig := &kafka.ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+		"transactional.id":  "eos",
+	}
+	config := Config{BatchSize: 4, BatchTimeout: 50 * time.Millisecond}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	// The first Processor fails midway: its ongoing transaction is aborted.
+	e, err := NewProcessor(consumerConfig, producerConfig, transform(30), config)
+	assert.NoError(err)
+	err = e.Run(ctx, []string{input})
+	assert.EqualError(err, "transform failure")
+	assert.NoError(e.Close())
+
+	// The second Processor resumes from the committed offsets.
+	e, err = NewProcessor(consumerConfig, producerConfig, transform(-1), config)
+	assert.NoError(err)
+	defer e.Close()
+
+	outputConsumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+		"group.id":          "output",
+		"auto.offset.reset": "earliest",
+		"isolation.level":   "read_committed",
+	})
+	assert.NoError(err)
+	defer outputConsumer.Close()
+	assert.NoError(outputConsumer.Subscribe(output, nil))
+
+	runCtx, stop := context.WithCancel(ctx)
+	defer stop()
+	done := make(chan error)
+	go func() {
+		done <- e.Run(runCtx, []string{input})
+	}()
+
+	var values []string
+	for len(values) < len(expected) && ctx.Err() == nil {
+		msg, err := outputConsumer.ReadMessage(100 * time.Millisecond)
+		if err == nil {
+			values = append(values, string(msg.Value))
+		}
+	}
+	stop()
+	assert.Equal(context.Canceled, <-done)
+
+	// Duplicates would be read after the expected messages.
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		msg, err := outputConsumer.ReadMessage(100 * time.Millisecond)
+		if err == nil {
+			values = append(values, string(msg.Value))
+		}
+	}
+
+	sort.Strings(values)
+	assert.Equal(expected, values, "Each output message should be read exactly once")
+
+	committed, err := e.Consumer().Committed([]kafka.TopicPartition{
+		{Topic: &input, Partition: 0},
+		{Topic: &input, Partition: 1}}, 10*1000)
+	assert.NoError(err)
+	for _, tp := range committed {
+		assert.Equal(kafka.Offset(msgcnt/2), tp.Offset,
+			"All input offsets should be committed with the transactions")
+	}
+}
@@ -73,6 +73,56 @@ rd_kafka_event_t *_rk_queue_poll (rd_kafka_queue_t *rkq, int timeoutMs,
 
     return rkev;
 }
+
+// Polls up to maxMsgs consecutive FETCH events from rkq, waiting at most
+// timeoutMs for the first one, and extracts each message into gMsgs.
+// The FETCH events are stored in rkevs and must be destroyed by the caller
+// (see _rk_events_destroy()).
+// Polling stops at the first non-FETCH event (or poll timeout), which is
+// returned in *other_rkev and *other_evtype.
+// Returns the number of messages extracted.
+int _rk_queue_poll_batch (rd_kafka_queue_t *rkq, int timeoutMs,
+                          glue_msg_t *gMsgs, rd_kafka_event_t **rkevs,
+                          int maxMsgs, int8_t want_hdrs,
+                          rd_kafka_event_t **other_rkev,
+                          rd_kafka_event_type_t *other_evtype) {
+    int cnt = 0;
+
+    *other_rkev = NULL;
+    *other_evtype = RD_KAFKA_EVENT_NONE;
+
+    while (cnt < maxMsgs) {
+        rd_kafka_event_t *rkev;
+        rd_kafka_event_type_t evtype;
+        glue_msg_t *gMsg = &gMsgs[cnt];
+
+        rkev = rd_kafka_queue_poll(rkq, cnt == 0 ? timeoutMs : 0);
+        evtype = rd_kafka_event_type(rkev);
+
+        if (evtype != RD_KAFKA_EVENT_FETCH) {
+            *other_rkev = rkev;
+            *other_evtype = evtype;
+            break;
+        }
+
+        gMsg->msg = (rd_kafka_message_t *)rd_kafka_event_message_next(rkev);
+        gMsg->ts = rd_kafka_message_timestamp(gMsg->msg, &gMsg->tstype);
+        gMsg->want_hdrs = want_hdrs;
+
+        if (want_hdrs)
+            chdrs_to_tmphdrs(gMsg);
+
+        rkevs[cnt++] = rkev;
+    }
+
+    return cnt;
+}
+
+void _rk_events_destroy (rd_kafka_event_t **rkevs, int cnt) {
+    int i;
+    for (i = 0 ; i < cnt ; i++)
+        rd_kafka_event_destroy(rkevs[i]);
+}
 */
 import "C"
 
@@ -97,6 +147,12 @@ func (e Stats) String() string {
 	return e.statsJSON
 }
 
+// StatsCb is called with each Stats event, see the `go.stats.cb`
+// configuration property.
+// It is called from the goroutine polling the client's events, in addition
+// to the Stats event being returned to the application, and must not block.
+type StatsCb func(stats *Stats)
+
 // AssignedPartitions consumer group rebalance event: assigned partition set
 type AssignedPartitions struct {
 	Partitions []TopicPartition
@@ -169,148 +225,222 @@ out:
 		prevRkev = rkev
 		timeoutMs = 0
 
-		retval = nil
-
-		switch evtype {
-		case C.RD_KAFKA_EVENT_FETCH:
-			// Consumer fetch event, new message.
-			// Extracted into temporary gMsg for optimization
-			retval = h.newMessageFromGlueMsg(&gMsg)
-
-		case C.RD_KAFKA_EVENT_REBALANCE:
-			// Consumer rebalance event
-			retval = h.c.handleRebalanceEvent(channel, rkev)
-
-		case C.RD_KAFKA_EVENT_ERROR:
-			// Error event
-			cErr := C.rd_kafka_event_error(rkev)
-			if cErr == C.RD_KAFKA_RESP_ERR__PARTITION_EOF {
-				crktpar := C.rd_kafka_event_topic_partition(rkev)
-				if crktpar == nil {
-					break
-				}
-
-				defer C.rd_kafka_topic_partition_destroy(crktpar)
-				var peof PartitionEOF
-				setupTopicPartitionFromCrktpar((*TopicPartition)(&peof), crktpar)
-
-				retval = peof
-
-			} else if int(C.rd_kafka_event_error_is_fatal(rkev)) != 0 {
-				// A fatal error has been raised.
-				// Extract the actual error from the client
-				// instance and return a new Error with
-				// fatal set to true.
-				cFatalErrstrSize := C.size_t(512)
-				cFatalErrstr := (*C.char)(C.malloc(cFatalErrstrSize))
-				defer C.free(unsafe.Pointer(cFatalErrstr))
-				cFatalErr := C.rd_kafka_fatal_error(h.rk, cFatalErrstr, cFatalErrstrSize)
-				fatalErr := newErrorFromCString(cFatalErr, cFatalErrstr)
-				fatalErr.fatal = true
-				retval = fatalErr
+		var done bool
+		retval, term, done = h.eventFromC(channel, rkev, evtype, &gMsg, termChan)
+		if done {
+			break
+		}
 
+		if retval != nil {
+			if channel != nil {
+				select {
+				case channel <- retval:
+				case <-termChan:
+					retval = nil
+					term = true
+					break out
+				}
 			} else {
-				retval = newErrorFromCString(cErr, C.rd_kafka_event_error_string(rkev))
+				break out
 			}
+		}
+	}
 
-		case C.RD_KAFKA_EVENT_STATS:
-			retval = &Stats{C.GoString(C.rd_kafka_event_stats(rkev))}
-
-		case C.RD_KAFKA_EVENT_DR:
-			// Producer Delivery Report event
-			// Each such event contains delivery reports for all
-			// messages in the produced batch.
-			// Forward delivery reports to per-message's response channel
-			// or to the global Producer.Events channel, or none.
-			rkmessages := make([]*C.rd_kafka_message_t, int(C.rd_kafka_event_message_count(rkev)))
-
-			cnt := int(C.rd_kafka_event_message_array(rkev, (**C.rd_kafka_message_t)(unsafe.Pointer(&rkmessages[0])), C.size_t(len(rkmessages))))
-
-			for _, rkmessage := range rkmessages[:cnt] {
-				msg := h.newMessageFromC(rkmessage)
-				var ch *chan Event
-
-				if rkmessage._private != nil {
-					// Find cgoif by id
-					cg, found := h.cgoGet((int)((uintptr)(rkmessage._private)))
-					if found {
-						cdr := cg.(cgoDr)
-
-						if cdr.deliveryChan != nil {
-							ch = &cdr.deliveryChan
-						}
-						msg.Opaque = cdr.opaque
-					}
-				}
+	if prevRkev != nil {
+		C.rd_kafka_event_destroy(prevRkev)
+	}
 
-				if ch == nil && h.fwdDr {
-					ch = &channel
-				}
+	return retval, term
+}
 
-				if ch != nil {
-					select {
-					case *ch <- msg:
-					case <-termChan:
-						retval = nil
-						term = true
-						break out
-					}
+// queueEventPoll polls a single event from the C queue rkqu, which is
+// not the handler's queue, and translates it into an Event.
+// returns nil on timeout.
+func (h *handle) queueEventPoll(rkqu *C.rd_kafka_queue_t, timeoutMs int) Event {
+	var evtype C.rd_kafka_event_type_t
+	var gMsg C.glue_msg_t
+	gMsg.want_hdrs = C.int8_t(bool2cint(h.msgFields.Headers))
+	rkev := C._rk_queue_poll(rkqu, C.int(timeoutMs), &evtype, &gMsg, nil)
+	if rkev == nil {
+		return nil
+	}
+	defer C.rd_kafka_event_destroy(rkev)
 
-				} else {
-					retval = msg
-					break out
-				}
-			}
+	ev, _, _ := h.eventFromC(nil, rkev, evtype, &gMsg, nil)
+	return ev
+}
 
-		case C.RD_KAFKA_EVENT_OFFSET_COMMIT:
-			// Offsets committed
-			cErr := C.rd_kafka_event_error(rkev)
-			coffsets := C.rd_kafka_event_topic_partition_list(rkev)
-			var offsets []TopicPartition
-			if coffsets != nil {
-				offsets = newTopicPartitionsFromCparts(coffsets)
-			}
+// eventPollBatch polls up to maxMsgs consecutive messages from the handler's
+// C rd_kafka_queue_t in a single cgo call, waiting at most timeoutMs for the
+// first one.
+// Polling stops at the first non-message event, which is translated
+// into an Event (see eventFromC()) without a forwarding channel, thus
+// rebalances are served by handleRebalanceEvent() as for Poll().
+// rd_kafka_consume_batch_queue() is not used since it serves rebalance
+// events internally, bypassing the application's rebalance callback,
+// and silently drops other events.
+// returns the messages polled, the terminating Event, if any, and
+// whether the poll timed out with no further events available.
+func (h *handle) eventPollBatch(timeoutMs int, maxMsgs int) (msgs []*Message, ev Event, timedOut bool) {
+	gMsgs := make([]C.glue_msg_t, maxMsgs)
+	rkevs := make([]*C.rd_kafka_event_t, maxMsgs)
+	var otherRkev *C.rd_kafka_event_t
+	var otherEvtype C.rd_kafka_event_type_t
+
+	cnt := int(C._rk_queue_poll_batch(h.rkq, C.int(timeoutMs),
+		&gMsgs[0], &rkevs[0], C.int(maxMsgs),
+		C.int8_t(bool2cint(h.msgFields.Headers)),
+		&otherRkev, &otherEvtype))
+
+	msgs = make([]*Message, cnt)
+	for i := range msgs {
+		msgs[i] = h.newMessageFromGlueMsg(&gMsgs[i])
+	}
 
-			if cErr != C.RD_KAFKA_RESP_ERR_NO_ERROR {
-				retval = OffsetsCommitted{newErrorFromCString(cErr, C.rd_kafka_event_error_string(rkev)), offsets}
-			} else {
-				retval = OffsetsCommitted{nil, offsets}
-			}
+	if cnt > 0 {
+		C._rk_events_destroy(&rkevs[0], C.int(cnt))
+	}
 
-		case C.RD_KAFKA_EVENT_OAUTHBEARER_TOKEN_REFRESH:
-			ev := OAuthBearerTokenRefresh{C.GoString(C.rd_kafka_event_config_string(rkev))}
-			retval = ev
+	if cnt < maxMsgs {
+		ev, _, timedOut = h.eventFromC(nil, otherRkev, otherEvtype, nil, nil)
+		if otherRkev != nil {
+			C.rd_kafka_event_destroy(otherRkev)
+		}
+	}
 
-		case C.RD_KAFKA_EVENT_NONE:
-			// poll timed out: no events available
-			break out
+	return msgs, ev, timedOut
+}
 
-		default:
-			if rkev != nil {
-				fmt.Fprintf(os.Stderr, "Ignored event %s\n",
-					C.GoString(C.rd_kafka_event_name(rkev)))
+// eventFromC translates the C event rkev of type evtype into an Event.
+// gMsg holds the message extracted by _rk_queue_poll for FETCH events.
+// Delivery reports are forwarded to their delivery channel, or to `channel`
+// if non-nil, in which case termChan is monitored as in eventPoll().
+// returns (event Event, terminate bool, done bool) where done indicates
+// that the caller must stop polling: either the poll timed out, termChan
+// received a termination event, or a delivery report is returned as the event.
+func (h *handle) eventFromC(channel chan Event, rkev *C.rd_kafka_event_t, evtype C.rd_kafka_event_type_t, gMsg *C.glue_msg_t, termChan chan bool) (retval Event, term bool, done bool) {
+	switch evtype {
+	case C.RD_KAFKA_EVENT_FETCH:
+		// Consumer fetch event, new message.
+		// Extracted into temporary gMsg for optimization
+		retval = h.newMessageFromGlueMsg(gMsg)
+
+	case C.RD_KAFKA_EVENT_REBALANCE:
+		// Consumer rebalance event
+		retval = h.c.handleRebalanceEvent(channel, rkev)
+
+	case C.RD_KAFKA_EVENT_ERROR:
+		// Error event
+		cErr := C.rd_kafka_event_error(rkev)
+		if cErr == C.RD_KAFKA_RESP_ERR__PARTITION_EOF {
+			crktpar := C.rd_kafka_event_topic_partition(rkev)
+			if crktpar == nil {
+				break
 			}
 
+			defer C.rd_kafka_topic_partition_destroy(crktpar)
+			var peof PartitionEOF
+			setupTopicPartitionFromCrktpar((*TopicPartition)(&peof), crktpar)
+
+			retval = peof
+
+		} else if int(C.rd_kafka_event_error_is_fatal(rkev)) != 0 {
+			// A fatal error has been raised.
+			// Extract the actual error from the client
+			// instance and return a new Error with
+			// fatal set to true.
+			cFatalErrstrSize := C.size_t(512)
+			cFatalErrstr := (*C.char)(C.malloc(cFatalErrstrSize))
+			defer C.free(unsafe.Pointer(cFatalErrstr))
+			cFatalErr := C.rd_kafka_fatal_error(h.rk, cFatalErrstr, cFatalErrstrSize)
+			fatalErr := newErrorFromCString(cFatalErr, cFatalErrstr)
+			fatalErr.fatal = true
+			retval = fatalErr
+
+		} else {
+			retval = newErrorFromCString(cErr, C.rd_kafka_event_error_string(rkev))
 		}
 
-		if retval != nil {
-			if channel != nil {
+	case C.RD_KAFKA_EVENT_STATS:
+		stats := &Stats{C.GoString(C.rd_kafka_event_stats(rkev))}
+		if h.statsCb != nil {
+			h.statsCb(stats)
+		}
+		retval = stats
+
+	case C.RD_KAFKA_EVENT_DR:
+		// Producer Delivery Report event
+		// Each such event contains delivery reports for all
+		// messages in the produced batch.
+		// Forward delivery reports to per-message's response channel
+		// or to the global Producer.Events channel, or none.
+		rkmessages := make([]*C.rd_kafka_message_t, int(C.rd_kafka_event_message_count(rkev)))
+
+		cnt := int(C.rd_kafka_event_message_array(rkev, (**C.rd_kafka_message_t)(unsafe.Pointer(&rkmessages[0])), C.size_t(len(rkmessages))))
+
+		for _, rkmessage := range rkmessages[:cnt] {
+			msg := h.newMessageFromC(rkmessage)
+			var ch *chan Event
+
+			if rkmessage._private != nil {
+				// Find cgoif by id
+				cg, found := h.cgoGet((int)((uintptr)(rkmessage._private)))
+				if found {
+					cdr := cg.(cgoDr)
+
+					if cdr.deliveryChan != nil {
+						ch = &cdr.deliveryChan
+					}
+					msg.Opaque = cdr.opaque
+				}
+			}
+
+			if ch == nil && h.fwdDr {
+				ch = &channel
+			}
+
+			if ch != nil {
 				select {
-				case channel <- retval:
+				case *ch <- msg:
 				case <-termChan:
-					retval = nil
-					term = true
-					break out
+					return nil, true, true
 				}
+
 			} else {
-				break out
+				return msg, false, true
 			}
 		}
-	}
 
-	if prevRkev != nil {
-		C.rd_kafka_event_destroy(prevRkev)
+	case C.RD_KAFKA_EVENT_OFFSET_COMMIT:
+		// Offsets committed
+		cErr := C.rd_kafka_event_error(rkev)
+		coffsets := C.rd_kafka_event_topic_partition_list(rkev)
+		var offsets []TopicPartition
+		if coffsets != nil {
+			offsets = newTopicPartitionsFromCparts(coffsets)
+		}
+
+		if cErr != C.RD_KAFKA_RESP_ERR_NO_ERROR {
+			retval = OffsetsCommitted{newErrorFromCString(cErr, C.rd_kafka_event_error_string(rkev)), offsets}
+		} else {
+			retval = OffsetsCommitted{nil, offsets}
+		}
+
+	case C.RD_KAFKA_EVENT_OAUTHBEARER_TOKEN_REFRESH:
+		ev := OAuthBearerTokenRefresh{C.GoString(C.rd_kafka_event_config_string(rkev))}
+		retval = ev
+
+	case C.RD_KAFKA_EVENT_NONE:
+		// poll timed out: no events available
+		return nil, false, true
+
+	default:
+		if rkev != nil {
+			fmt.Fprintf(os.Stderr, "Ignored event %s\n",
+				C.GoString(C.rd_kafka_event_name(rkev)))
+		}
+
 	}
 
-	return retval, term
+	return retval, false, false
 }
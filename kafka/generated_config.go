@@ -0,0 +1,2632 @@
+package kafka
+
+// Copyright 2016-2026 Confluent Inc.
+// AUTOMATICALLY GENERATED ON 2026-10-16 13:24:45.189623995 +0000 UTC m=+0.000546537 USING librdkafka-2.10.0/CONFIGURATION.md
+
+// BuiltinFeaturesFlag is a flag of the builtin.features configuration property.
+type BuiltinFeaturesFlag string
+
+// BuiltinFeaturesFlag values
+const (
+	BuiltinFeaturesFlagGzip            BuiltinFeaturesFlag = "gzip"
+	BuiltinFeaturesFlagSnappy          BuiltinFeaturesFlag = "snappy"
+	BuiltinFeaturesFlagSsl             BuiltinFeaturesFlag = "ssl"
+	BuiltinFeaturesFlagSasl            BuiltinFeaturesFlag = "sasl"
+	BuiltinFeaturesFlagRegex           BuiltinFeaturesFlag = "regex"
+	BuiltinFeaturesFlagLz4             BuiltinFeaturesFlag = "lz4"
+	BuiltinFeaturesFlagSaslGssapi      BuiltinFeaturesFlag = "sasl_gssapi"
+	BuiltinFeaturesFlagSaslPlain       BuiltinFeaturesFlag = "sasl_plain"
+	BuiltinFeaturesFlagSaslScram       BuiltinFeaturesFlag = "sasl_scram"
+	BuiltinFeaturesFlagPlugins         BuiltinFeaturesFlag = "plugins"
+	BuiltinFeaturesFlagZstd            BuiltinFeaturesFlag = "zstd"
+	BuiltinFeaturesFlagSaslOauthbearer BuiltinFeaturesFlag = "sasl_oauthbearer"
+	BuiltinFeaturesFlagHttp            BuiltinFeaturesFlag = "http"
+	BuiltinFeaturesFlagOidc            BuiltinFeaturesFlag = "oidc"
+)
+
+// MetadataRecoveryStrategy is a value of the metadata.recovery.strategy configuration property.
+type MetadataRecoveryStrategy string
+
+// MetadataRecoveryStrategy values
+const (
+	MetadataRecoveryStrategyNone        MetadataRecoveryStrategy = "none"
+	MetadataRecoveryStrategyRebootstrap MetadataRecoveryStrategy = "rebootstrap"
+)
+
+// DebugFlag is a flag of the debug configuration property.
+type DebugFlag string
+
+// DebugFlag values
+const (
+	DebugFlagGeneric     DebugFlag = "generic"
+	DebugFlagBroker      DebugFlag = "broker"
+	DebugFlagTopic       DebugFlag = "topic"
+	DebugFlagMetadata    DebugFlag = "metadata"
+	DebugFlagFeature     DebugFlag = "feature"
+	DebugFlagQueue       DebugFlag = "queue"
+	DebugFlagMsg         DebugFlag = "msg"
+	DebugFlagProtocol    DebugFlag = "protocol"
+	DebugFlagCgrp        DebugFlag = "cgrp"
+	DebugFlagSecurity    DebugFlag = "security"
+	DebugFlagFetch       DebugFlag = "fetch"
+	DebugFlagInterceptor DebugFlag = "interceptor"
+	DebugFlagPlugin      DebugFlag = "plugin"
+	DebugFlagConsumer    DebugFlag = "consumer"
+	DebugFlagAdmin       DebugFlag = "admin"
+	DebugFlagEos         DebugFlag = "eos"
+	DebugFlagMock        DebugFlag = "mock"
+	DebugFlagAssignor    DebugFlag = "assignor"
+	DebugFlagConf        DebugFlag = "conf"
+	DebugFlagTelemetry   DebugFlag = "telemetry"
+	DebugFlagAll         DebugFlag = "all"
+)
+
+// BrokerAddressFamily is a value of the broker.address.family configuration property.
+type BrokerAddressFamily string
+
+// BrokerAddressFamily values
+const (
+	BrokerAddressFamilyAny BrokerAddressFamily = "any"
+	BrokerAddressFamilyV4  BrokerAddressFamily = "v4"
+	BrokerAddressFamilyV6  BrokerAddressFamily = "v6"
+)
+
+// SecurityProtocol is a value of the security.protocol configuration property.
+type SecurityProtocol string
+
+// SecurityProtocol values
+const (
+	SecurityProtocolPlaintext     SecurityProtocol = "plaintext"
+	SecurityProtocolSsl           SecurityProtocol = "ssl"
+	SecurityProtocolSaslPlaintext SecurityProtocol = "sasl_plaintext"
+	SecurityProtocolSaslSsl       SecurityProtocol = "sasl_ssl"
+)
+
+// SslEndpointIdentificationAlgorithm is a value of the ssl.endpoint.identification.algorithm configuration property.
+type SslEndpointIdentificationAlgorithm string
+
+// SslEndpointIdentificationAlgorithm values
+const (
+	SslEndpointIdentificationAlgorithmNone  SslEndpointIdentificationAlgorithm = "none"
+	SslEndpointIdentificationAlgorithmHttps SslEndpointIdentificationAlgorithm = "https"
+)
+
+// SaslOauthbearerMethod is a value of the sasl.oauthbearer.method configuration property.
+type SaslOauthbearerMethod string
+
+// SaslOauthbearerMethod values
+const (
+	SaslOauthbearerMethodDefault SaslOauthbearerMethod = "default"
+	SaslOauthbearerMethodOidc    SaslOauthbearerMethod = "oidc"
+)
+
+// GroupProtocol is a value of the group.protocol configuration property.
+type GroupProtocol string
+
+// GroupProtocol values
+const (
+	GroupProtocolClassic  GroupProtocol = "classic"
+	GroupProtocolConsumer GroupProtocol = "consumer"
+)
+
+// OffsetStoreMethod is a value of the offset.store.method configuration property.
+type OffsetStoreMethod string
+
+// OffsetStoreMethod values
+const (
+	OffsetStoreMethodNone   OffsetStoreMethod = "none"
+	OffsetStoreMethodFile   OffsetStoreMethod = "file"
+	OffsetStoreMethodBroker OffsetStoreMethod = "broker"
+)
+
+// ConsumerIsolationLevel is a value of the isolation.level configuration property.
+type ConsumerIsolationLevel string
+
+// ConsumerIsolationLevel values
+const (
+	ConsumerIsolationLevelReadUncommitted ConsumerIsolationLevel = "read_uncommitted"
+	ConsumerIsolationLevelReadCommitted   ConsumerIsolationLevel = "read_committed"
+)
+
+// CompressionCodec is a value of the compression.codec configuration property.
+type CompressionCodec string
+
+// CompressionCodec values
+const (
+	CompressionCodecNone   CompressionCodec = "none"
+	CompressionCodecGzip   CompressionCodec = "gzip"
+	CompressionCodecSnappy CompressionCodec = "snappy"
+	CompressionCodecLz4    CompressionCodec = "lz4"
+	CompressionCodecZstd   CompressionCodec = "zstd"
+)
+
+// ClientDnsLookup is a value of the client.dns.lookup configuration property.
+type ClientDnsLookup string
+
+// ClientDnsLookup values
+const (
+	ClientDnsLookupUseAllDnsIps                         ClientDnsLookup = "use_all_dns_ips"
+	ClientDnsLookupResolveCanonicalBootstrapServersOnly ClientDnsLookup = "resolve_canonical_bootstrap_servers_only"
+)
+
+// QueuingStrategy is a value of the queuing.strategy configuration property.
+type QueuingStrategy string
+
+// QueuingStrategy values
+const (
+	QueuingStrategyFifo QueuingStrategy = "fifo"
+	QueuingStrategyLifo QueuingStrategy = "lifo"
+)
+
+// AutoOffsetReset is a value of the auto.offset.reset configuration property.
+type AutoOffsetReset string
+
+// AutoOffsetReset values
+const (
+	AutoOffsetResetSmallest  AutoOffsetReset = "smallest"
+	AutoOffsetResetEarliest  AutoOffsetReset = "earliest"
+	AutoOffsetResetBeginning AutoOffsetReset = "beginning"
+	AutoOffsetResetLargest   AutoOffsetReset = "largest"
+	AutoOffsetResetLatest    AutoOffsetReset = "latest"
+	AutoOffsetResetEnd       AutoOffsetReset = "end"
+	AutoOffsetResetError     AutoOffsetReset = "error"
+)
+
+// configProperties are the librdkafka configuration properties, by name.
+var configProperties = map[string]*configProperty{
+	"builtin.features":                         {name: "builtin.features", scope: configScopeAll, typ: configTypeFlags, values: []string{"gzip", "snappy", "ssl", "sasl", "regex", "lz4", "sasl_gssapi", "sasl_plain", "sasl_scram", "plugins", "zstd", "sasl_oauthbearer", "http", "oidc"}},
+	"client.id":                                {name: "client.id", scope: configScopeAll, typ: configTypeString},
+	"client.software.name":                     {name: "client.software.name", scope: configScopeAll, typ: configTypeString},
+	"client.software.version":                  {name: "client.software.version", scope: configScopeAll, typ: configTypeString},
+	"metadata.broker.list":                     {name: "metadata.broker.list", scope: configScopeAll, typ: configTypeString},
+	"bootstrap.servers":                        {name: "bootstrap.servers", scope: configScopeAll, typ: configTypeString, alias: "metadata.broker.list"},
+	"message.max.bytes":                        {name: "message.max.bytes", scope: configScopeAll, typ: configTypeInt, min: 1000, max: 1000000000},
+	"message.copy.max.bytes":                   {name: "message.copy.max.bytes", scope: configScopeAll, typ: configTypeInt, min: 0, max: 1000000000},
+	"receive.message.max.bytes":                {name: "receive.message.max.bytes", scope: configScopeAll, typ: configTypeInt, min: 1000, max: 2147483647},
+	"max.in.flight.requests.per.connection":    {name: "max.in.flight.requests.per.connection", scope: configScopeAll, typ: configTypeInt, min: 1, max: 1000000},
+	"max.in.flight":                            {name: "max.in.flight", scope: configScopeAll, typ: configTypeInt, min: 1, max: 1000000, alias: "max.in.flight.requests.per.connection"},
+	"metadata.recovery.strategy":               {name: "metadata.recovery.strategy", scope: configScopeAll, typ: configTypeEnum, values: []string{"none", "rebootstrap"}},
+	"metadata.recovery.rebootstrap.trigger.ms": {name: "metadata.recovery.rebootstrap.trigger.ms", scope: configScopeAll, typ: configTypeInt, min: 0, max: 2147483647},
+	"topic.metadata.refresh.interval.ms":       {name: "topic.metadata.refresh.interval.ms", scope: configScopeAll, typ: configTypeInt, min: -1, max: 3600000},
+	"metadata.max.age.ms":                      {name: "metadata.max.age.ms", scope: configScopeAll, typ: configTypeInt, min: 1, max: 86400000},
+	"topic.metadata.refresh.fast.interval.ms":  {name: "topic.metadata.refresh.fast.interval.ms", scope: configScopeAll, typ: configTypeInt, min: 1, max: 60000},
+	"topic.metadata.refresh.fast.cnt":          {name: "topic.metadata.refresh.fast.cnt", scope: configScopeAll, typ: configTypeInt, min: 0, max: 1000, deprecated: true},
+	"topic.metadata.refresh.sparse":            {name: "topic.metadata.refresh.sparse", scope: configScopeAll, typ: configTypeBool},
+	"topic.metadata.propagation.max.ms":        {name: "topic.metadata.propagation.max.ms", scope: configScopeAll, typ: configTypeInt, min: 0, max: 3600000},
+	"topic.blacklist":                          {name: "topic.blacklist", scope: configScopeAll, typ: configTypeString},
+	"debug":                                    {name: "debug", scope: configScopeAll, typ: configTypeFlags, values: []string{"generic", "broker", "topic", "metadata", "feature", "queue", "msg", "protocol", "cgrp", "security", "fetch", "interceptor", "plugin", "consumer", "admin", "eos", "mock", "assignor", "conf", "telemetry", "all"}},
+	"socket.timeout.ms":                        {name: "socket.timeout.ms", scope: configScopeAll, typ: configTypeInt, min: 10, max: 300000},
+	"socket.blocking.max.ms":                   {name: "socket.blocking.max.ms", scope: configScopeAll, typ: configTypeInt, min: 1, max: 60000, deprecated: true},
+	"socket.send.buffer.bytes":                 {name: "socket.send.buffer.bytes", scope: configScopeAll, typ: configTypeInt, min: 0, max: 100000000},
+	"socket.receive.buffer.bytes":              {name: "socket.receive.buffer.bytes", scope: configScopeAll, typ: configTypeInt, min: 0, max: 100000000},
+	"socket.keepalive.enable":                  {name: "socket.keepalive.enable", scope: configScopeAll, typ: configTypeBool},
+	"socket.nagle.disable":                     {name: "socket.nagle.disable", scope: configScopeAll, typ: configTypeBool},
+	"socket.max.fails":                         {name: "socket.max.fails", scope: configScopeAll, typ: configTypeInt, min: 0, max: 1000000},
+	"broker.address.ttl":                       {name: "broker.address.ttl", scope: configScopeAll, typ: configTypeInt, min: 0, max: 86400000},
+	"broker.address.family":                    {name: "broker.address.family", scope: configScopeAll, typ: configTypeEnum, values: []string{"any", "v4", "v6"}},
+	"socket.connection.setup.timeout.ms":       {name: "socket.connection.setup.timeout.ms", scope: configScopeAll, typ: configTypeInt, min: 1000, max: 2147483647},
+	"connections.max.idle.ms":                  {name: "connections.max.idle.ms", scope: configScopeAll, typ: configTypeInt, min: 0, max: 2147483647},
+	"reconnect.backoff.jitter.ms":              {name: "reconnect.backoff.jitter.ms", scope: configScopeAll, typ: configTypeInt, min: 0, max: 3600000, deprecated: true},
+	"reconnect.backoff.ms":                     {name: "reconnect.backoff.ms", scope: configScopeAll, typ: configTypeInt, min: 0, max: 3600000},
+	"reconnect.backoff.max.ms":                 {name: "reconnect.backoff.max.ms", scope: configScopeAll, typ: configTypeInt, min: 0, max: 3600000},
+	"statistics.interval.ms":                   {name: "statistics.interval.ms", scope: configScopeAll, typ: configTypeInt, min: 0, max: 86400000},
+	"enabled_events":                           {name: "enabled_events", scope: configScopeAll, typ: configTypeInt, min: 0, max: 2147483647},
+	"error_cb":                                 {name: "error_cb", scope: configScopeAll, typ: configTypePointer},
+	"throttle_cb":                              {name: "throttle_cb", scope: configScopeAll, typ: configTypePointer},
+	"stats_cb":                                 {name: "stats_cb", scope: configScopeAll, typ: configTypePointer},
+	"log_cb":                                   {name: "log_cb", scope: configScopeAll, typ: configTypePointer},
+	"log_level":                                {name: "log_level", scope: configScopeAll, typ: configTypeInt, min: 0, max: 7},
+	"log.queue":                                {name: "log.queue", scope: configScopeAll, typ: configTypeBool},
+	"log.thread.name":                          {name: "log.thread.name", scope: configScopeAll, typ: configTypeBool},
+	"enable.random.seed":                       {name: "enable.random.seed", scope: configScopeAll, typ: configTypeBool},
+	"log.connection.close":                     {name: "log.connection.close", scope: configScopeAll, typ: configTypeBool},
+	"background_event_cb":                      {name: "background_event_cb", scope: configScopeAll, typ: configTypePointer},
+	"socket_cb":                                {name: "socket_cb", scope: configScopeAll, typ: configTypePointer},
+	"connect_cb":                               {name: "connect_cb", scope: configScopeAll, typ: configTypePointer},
+	"closesocket_cb":                           {name: "closesocket_cb", scope: configScopeAll, typ: configTypePointer},
+	"open_cb":                                  {name: "open_cb", scope: configScopeAll, typ: configTypePointer},
+	"resolve_cb":                               {name: "resolve_cb", scope: configScopeAll, typ: configTypePointer},
+	"opaque":                                   {name: "opaque", scope: configScopeAll, typ: configTypePointer},
+	"default_topic_conf":                       {name: "default_topic_conf", scope: configScopeAll, typ: configTypePointer},
+	"internal.termination.signal":              {name: "internal.termination.signal", scope: configScopeAll, typ: configTypeInt, min: 0, max: 128},
+	"api.version.request":                      {name: "api.version.request", scope: configScopeAll, typ: configTypeBool},
+	"api.version.request.timeout.ms":           {name: "api.version.request.timeout.ms", scope: configScopeAll, typ: configTypeInt, min: 1, max: 300000},
+	"api.version.fallback.ms":                  {name: "api.version.fallback.ms", scope: configScopeAll, typ: configTypeInt, min: 0, max: 604800000},
+	"broker.version.fallback":                  {name: "broker.version.fallback", scope: configScopeAll, typ: configTypeString},
+	"allow.auto.create.topics":                 {name: "allow.auto.create.topics", scope: configScopeAll, typ: configTypeBool},
+	"security.protocol":                        {name: "security.protocol", scope: configScopeAll, typ: configTypeEnum, values: []string{"plaintext", "ssl", "sasl_plaintext", "sasl_ssl"}},
+	"ssl.cipher.suites":                        {name: "ssl.cipher.suites", scope: configScopeAll, typ: configTypeString},
+	"ssl.curves.list":                          {name: "ssl.curves.list", scope: configScopeAll, typ: configTypeString},
+	"ssl.sigalgs.list":                         {name: "ssl.sigalgs.list", scope: configScopeAll, typ: configTypeString},
+	"ssl.key.location":                         {name: "ssl.key.location", scope: configScopeAll, typ: configTypeString},
+	"ssl.key.password":                         {name: "ssl.key.password", scope: configScopeAll, typ: configTypeString},
+	"ssl.key.pem":                              {name: "ssl.key.pem", scope: configScopeAll, typ: configTypeString},
+	"ssl_key":                                  {name: "ssl_key", scope: configScopeAll, typ: configTypePointer},
+	"ssl.certificate.location":                 {name: "ssl.certificate.location", scope: configScopeAll, typ: configTypeString},
+	"ssl.certificate.pem":                      {name: "ssl.certificate.pem", scope: configScopeAll, typ: configTypeString},
+	"ssl_certificate":                          {name: "ssl_certificate", scope: configScopeAll, typ: configTypePointer},
+	"ssl.ca.location":                          {name: "ssl.ca.location", scope: configScopeAll, typ: configTypeString},
+	"https.ca.location":                        {name: "https.ca.location", scope: configScopeAll, typ: configTypeString},
+	"https.ca.pem":                             {name: "https.ca.pem", scope: configScopeAll, typ: configTypeString},
+	"ssl.ca.pem":                               {name: "ssl.ca.pem", scope: configScopeAll, typ: configTypeString},
+	"ssl_ca":                                   {name: "ssl_ca", scope: configScopeAll, typ: configTypePointer},
+	"ssl.ca.certificate.stores":                {name: "ssl.ca.certificate.stores", scope: configScopeAll, typ: configTypeString},
+	"ssl.crl.location":                         {name: "ssl.crl.location", scope: configScopeAll, typ: configTypeString},
+	"ssl.keystore.location":                    {name: "ssl.keystore.location", scope: configScopeAll, typ: configTypeString},
+	"ssl.keystore.password":                    {name: "ssl.keystore.password", scope: configScopeAll, typ: configTypeString},
+	"ssl.providers":                            {name: "ssl.providers", scope: configScopeAll, typ: configTypeString},
+	"ssl.engine.location":                      {name: "ssl.engine.location", scope: configScopeAll, typ: configTypeString, deprecated: true},
+	"ssl.engine.id":                            {name: "ssl.engine.id", scope: configScopeAll, typ: configTypeString},
+	"ssl_engine_callback_data":                 {name: "ssl_engine_callback_data", scope: configScopeAll, typ: configTypePointer},
+	"enable.ssl.certificate.verification":      {name: "enable.ssl.certificate.verification", scope: configScopeAll, typ: configTypeBool},
+	"ssl.endpoint.identification.algorithm":    {name: "ssl.endpoint.identification.algorithm", scope: configScopeAll, typ: configTypeEnum, values: []string{"none", "https"}},
+	"ssl.certificate.verify_cb":                {name: "ssl.certificate.verify_cb", scope: configScopeAll, typ: configTypePointer},
+	"sasl.mechanisms":                          {name: "sasl.mechanisms", scope: configScopeAll, typ: configTypeString},
+	"sasl.mechanism":                           {name: "sasl.mechanism", scope: configScopeAll, typ: configTypeString, alias: "sasl.mechanisms"},
+	"sasl.kerberos.service.name":               {name: "sasl.kerberos.service.name", scope: configScopeAll, typ: configTypeString},
+	"sasl.kerberos.principal":                  {name: "sasl.kerberos.principal", scope: configScopeAll, typ: configTypeString},
+	"sasl.kerberos.kinit.cmd":                  {name: "sasl.kerberos.kinit.cmd", scope: configScopeAll, typ: configTypeString},
+	"sasl.kerberos.keytab":                     {name: "sasl.kerberos.keytab", scope: configScopeAll, typ: configTypeString},
+	"sasl.kerberos.min.time.before.relogin":    {name: "sasl.kerberos.min.time.before.relogin", scope: configScopeAll, typ: configTypeInt, min: 0, max: 86400000},
+	"sasl.username":                            {name: "sasl.username", scope: configScopeAll, typ: configTypeString},
+	"sasl.password":                            {name: "sasl.password", scope: configScopeAll, typ: configTypeString},
+	"sasl.oauthbearer.config":                  {name: "sasl.oauthbearer.config", scope: configScopeAll, typ: configTypeString},
+	"enable.sasl.oauthbearer.unsecure.jwt":     {name: "enable.sasl.oauthbearer.unsecure.jwt", scope: configScopeAll, typ: configTypeBool},
+	"oauthbearer_token_refresh_cb":             {name: "oauthbearer_token_refresh_cb", scope: configScopeAll, typ: configTypePointer},
+	"sasl.oauthbearer.method":                  {name: "sasl.oauthbearer.method", scope: configScopeAll, typ: configTypeEnum, values: []string{"default", "oidc"}},
+	"sasl.oauthbearer.client.id":               {name: "sasl.oauthbearer.client.id", scope: configScopeAll, typ: configTypeString},
+	"sasl.oauthbearer.client.secret":           {name: "sasl.oauthbearer.client.secret", scope: configScopeAll, typ: configTypeString},
+	"sasl.oauthbearer.scope":                   {name: "sasl.oauthbearer.scope", scope: configScopeAll, typ: configTypeString},
+	"sasl.oauthbearer.extensions":              {name: "sasl.oauthbearer.extensions", scope: configScopeAll, typ: configTypeString},
+	"sasl.oauthbearer.token.endpoint.url":      {name: "sasl.oauthbearer.token.endpoint.url", scope: configScopeAll, typ: configTypeString},
+	"plugin.library.paths":                     {name: "plugin.library.paths", scope: configScopeAll, typ: configTypeString},
+	"interceptors":                             {name: "interceptors", scope: configScopeAll, typ: configTypePointer},
+	"group.id":                                 {name: "group.id", scope: configScopeConsumer, typ: configTypeString},
+	"group.instance.id":                        {name: "group.instance.id", scope: configScopeConsumer, typ: configTypeString},
+	"partition.assignment.strategy":            {name: "partition.assignment.strategy", scope: configScopeConsumer, typ: configTypeString},
+	"session.timeout.ms":                       {name: "session.timeout.ms", scope: configScopeConsumer, typ: configTypeInt, min: 1, max: 3600000},
+	"heartbeat.interval.ms":                    {name: "heartbeat.interval.ms", scope: configScopeConsumer, typ: configTypeInt, min: 1, max: 3600000},
+	"group.protocol.type":                      {name: "group.protocol.type", scope: configScopeConsumer, typ: configTypeString},
+	"group.protocol":                           {name: "group.protocol", scope: configScopeConsumer, typ: configTypeEnum, values: []string{"classic", "consumer"}},
+	"group.remote.assignor":                    {name: "group.remote.assignor", scope: configScopeConsumer, typ: configTypeString},
+	"coordinator.query.interval.ms":            {name: "coordinator.query.interval.ms", scope: configScopeConsumer, typ: configTypeInt, min: 1, max: 3600000},
+	"max.poll.interval.ms":                     {name: "max.poll.interval.ms", scope: configScopeConsumer, typ: configTypeInt, min: 1, max: 86400000},
+	"enable.auto.commit":                       {name: "enable.auto.commit", scope: configScopeConsumer, typ: configTypeBool},
+	"auto.commit.interval.ms":                  {name: "auto.commit.interval.ms", scope: configScopeConsumer, typ: configTypeInt, min: 0, max: 86400000},
+	"enable.auto.offset.store":                 {name: "enable.auto.offset.store", scope: configScopeConsumer, typ: configTypeBool},
+	"queued.min.messages":                      {name: "queued.min.messages", scope: configScopeConsumer, typ: configTypeInt, min: 1, max: 10000000},
+	"queued.max.messages.kbytes":               {name: "queued.max.messages.kbytes", scope: configScopeConsumer, typ: configTypeInt, min: 1, max: 2097151},
+	"fetch.wait.max.ms":                        {name: "fetch.wait.max.ms", scope: configScopeConsumer, typ: configTypeInt, min: 0, max: 300000},
+	"fetch.queue.backoff.ms":                   {name: "fetch.queue.backoff.ms", scope: configScopeConsumer, typ: configTypeInt, min: 0, max: 300000},
+	"fetch.message.max.bytes":                  {name: "fetch.message.max.bytes", scope: configScopeConsumer, typ: configTypeInt, min: 1, max: 1000000000},
+	"max.partition.fetch.bytes":                {name: "max.partition.fetch.bytes", scope: configScopeConsumer, typ: configTypeInt, min: 1, max: 1000000000, alias: "fetch.message.max.bytes"},
+	"fetch.max.bytes":                          {name: "fetch.max.bytes", scope: configScopeConsumer, typ: configTypeInt, min: 0, max: 2147483135},
+	"fetch.min.bytes":                          {name: "fetch.min.bytes", scope: configScopeConsumer, typ: configTypeInt, min: 1, max: 100000000},
+	"fetch.error.backoff.ms":                   {name: "fetch.error.backoff.ms", scope: configScopeConsumer, typ: configTypeInt, min: 0, max: 300000},
+	"offset.store.method":                      {name: "offset.store.method", scope: configScopeConsumer, typ: configTypeEnum, values: []string{"none", "file", "broker"}, deprecated: true},
+	"isolation.level":                          {name: "isolation.level", scope: configScopeConsumer, typ: configTypeEnum, values: []string{"read_uncommitted", "read_committed"}},
+	"consume_cb":                               {name: "consume_cb", scope: configScopeConsumer, typ: configTypePointer},
+	"rebalance_cb":                             {name: "rebalance_cb", scope: configScopeConsumer, typ: configTypePointer},
+	"offset_commit_cb":                         {name: "offset_commit_cb", scope: configScopeConsumer, typ: configTypePointer},
+	"enable.partition.eof":                     {name: "enable.partition.eof", scope: configScopeConsumer, typ: configTypeBool},
+	"check.crcs":                               {name: "check.crcs", scope: configScopeConsumer, typ: configTypeBool},
+	"client.rack":                              {name: "client.rack", scope: configScopeAll, typ: configTypeString},
+	"transactional.id":                         {name: "transactional.id", scope: configScopeProducer, typ: configTypeString},
+	"transaction.timeout.ms":                   {name: "transaction.timeout.ms", scope: configScopeProducer, typ: configTypeInt, min: 1000, max: 2147483647},
+	"enable.idempotence":                       {name: "enable.idempotence", scope: configScopeProducer, typ: configTypeBool},
+	"enable.gapless.guarantee":                 {name: "enable.gapless.guarantee", scope: configScopeProducer, typ: configTypeBool},
+	"queue.buffering.max.messages":             {name: "queue.buffering.max.messages", scope: configScopeProducer, typ: configTypeInt, min: 0, max: 2147483647},
+	"queue.buffering.max.kbytes":               {name: "queue.buffering.max.kbytes", scope: configScopeProducer, typ: configTypeInt, min: 1, max: 2147483647},
+	"queue.buffering.max.ms":                   {name: "queue.buffering.max.ms", scope: configScopeProducer, typ: configTypeFloat, min: 0, max: 900000},
+	"linger.ms":                                {name: "linger.ms", scope: configScopeProducer, typ: configTypeFloat, min: 0, max: 900000, alias: "queue.buffering.max.ms"},
+	"message.send.max.retries":                 {name: "message.send.max.retries", scope: configScopeProducer, typ: configTypeInt, min: 0, max: 2147483647},
+	"retries":                                  {name: "retries", scope: configScopeProducer, typ: configTypeInt, min: 0, max: 2147483647, alias: "message.send.max.retries"},
+	"retry.backoff.ms":                         {name: "retry.backoff.ms", scope: configScopeAll, typ: configTypeInt, min: 1, max: 300000},
+	"retry.backoff.max.ms":                     {name: "retry.backoff.max.ms", scope: configScopeAll, typ: configTypeInt, min: 1, max: 300000},
+	"queue.buffering.backpressure.threshold":   {name: "queue.buffering.backpressure.threshold", scope: configScopeProducer, typ: configTypeInt, min: 1, max: 1000000},
+	"compression.codec":                        {name: "compression.codec", scope: configScopeProducer, typ: configTypeEnum, values: []string{"none", "gzip", "snappy", "lz4", "zstd"}},
+	"compression.type":                         {name: "compression.type", scope: configScopeProducer, typ: configTypeEnum, values: []string{"none", "gzip", "snappy", "lz4", "zstd"}, alias: "compression.codec"},
+	"batch.num.messages":                       {name: "batch.num.messages", scope: configScopeProducer, typ: configTypeInt, min: 1, max: 1000000},
+	"batch.size":                               {name: "batch.size", scope: configScopeProducer, typ: configTypeInt, min: 1, max: 2147483647},
+	"delivery.report.only.error":               {name: "delivery.report.only.error", scope: configScopeProducer, typ: configTypeBool},
+	"dr_cb":                                    {name: "dr_cb", scope: configScopeProducer, typ: configTypePointer},
+	"dr_msg_cb":                                {name: "dr_msg_cb", scope: configScopeProducer, typ: configTypePointer},
+	"sticky.partitioning.linger.ms":            {name: "sticky.partitioning.linger.ms", scope: configScopeProducer, typ: configTypeInt, min: 0, max: 900000},
+	"client.dns.lookup":                        {name: "client.dns.lookup", scope: configScopeAll, typ: configTypeEnum, values: []string{"use_all_dns_ips", "resolve_canonical_bootstrap_servers_only"}},
+	"enable.metrics.push":                      {name: "enable.metrics.push", scope: configScopeAll, typ: configTypeBool},
+	"request.required.acks":                    {name: "request.required.acks", scope: configScopeProducer, typ: configTypeInt, min: -1, max: 1000},
+	"acks":                                     {name: "acks", scope: configScopeProducer, typ: configTypeInt, min: -1, max: 1000, alias: "request.required.acks"},
+	"request.timeout.ms":                       {name: "request.timeout.ms", scope: configScopeProducer, typ: configTypeInt, min: 1, max: 900000},
+	"message.timeout.ms":                       {name: "message.timeout.ms", scope: configScopeProducer, typ: configTypeInt, min: 0, max: 2147483647},
+	"delivery.timeout.ms":                      {name: "delivery.timeout.ms", scope: configScopeProducer, typ: configTypeInt, min: 0, max: 2147483647, alias: "message.timeout.ms"},
+	"queuing.strategy":                         {name: "queuing.strategy", scope: configScopeProducer, typ: configTypeEnum, values: []string{"fifo", "lifo"}, deprecated: true},
+	"produce.offset.report":                    {name: "produce.offset.report", scope: configScopeProducer, typ: configTypeBool, deprecated: true},
+	"partitioner":                              {name: "partitioner", scope: configScopeProducer, typ: configTypeString},
+	"partitioner_cb":                           {name: "partitioner_cb", scope: configScopeProducer, typ: configTypePointer},
+	"msg_order_cmp":                            {name: "msg_order_cmp", scope: configScopeProducer, typ: configTypePointer, deprecated: true},
+	"compression.level":                        {name: "compression.level", scope: configScopeProducer, typ: configTypeInt, min: -1, max: 12},
+	"auto.commit.enable":                       {name: "auto.commit.enable", scope: configScopeConsumer, typ: configTypeBool, deprecated: true},
+	"auto.offset.reset":                        {name: "auto.offset.reset", scope: configScopeConsumer, typ: configTypeEnum, values: []string{"smallest", "earliest", "beginning", "largest", "latest", "end", "error"}},
+	"offset.store.path":                        {name: "offset.store.path", scope: configScopeConsumer, typ: configTypeString, deprecated: true},
+	"offset.store.sync.interval.ms":            {name: "offset.store.sync.interval.ms", scope: configScopeConsumer, typ: configTypeInt, min: -1, max: 86400000, deprecated: true},
+	"consume.callback.max.messages":            {name: "consume.callback.max.messages", scope: configScopeConsumer, typ: configTypeInt, min: 0, max: 1000000},
+}
+
+// BuiltinFeatures sets the builtin.features configuration property.
+func (c *ProducerConfig) BuiltinFeatures(flags ...BuiltinFeaturesFlag) *ProducerConfig {
+	c.set("builtin.features", joinFlags(flags))
+	return c
+}
+
+// ClientId sets the client.id configuration property.
+// Default: rdkafka.
+func (c *ProducerConfig) ClientId(value string) *ProducerConfig {
+	c.set("client.id", value)
+	return c
+}
+
+// ClientSoftwareName sets the client.software.name configuration property.
+// Default: librdkafka.
+func (c *ProducerConfig) ClientSoftwareName(value string) *ProducerConfig {
+	c.set("client.software.name", value)
+	return c
+}
+
+// ClientSoftwareVersion sets the client.software.version configuration property.
+func (c *ProducerConfig) ClientSoftwareVersion(value string) *ProducerConfig {
+	c.set("client.software.version", value)
+	return c
+}
+
+// MetadataBrokerList sets the metadata.broker.list configuration property.
+func (c *ProducerConfig) MetadataBrokerList(value string) *ProducerConfig {
+	c.set("metadata.broker.list", value)
+	return c
+}
+
+// BootstrapServers sets the bootstrap.servers configuration property, an alias for metadata.broker.list.
+func (c *ProducerConfig) BootstrapServers(value string) *ProducerConfig {
+	c.set("bootstrap.servers", value)
+	return c
+}
+
+// MessageMaxBytes sets the message.max.bytes configuration property.
+// Range: 1000 .. 1000000000. Default: 1000000.
+func (c *ProducerConfig) MessageMaxBytes(value int) *ProducerConfig {
+	c.set("message.max.bytes", value)
+	return c
+}
+
+// MessageCopyMaxBytes sets the message.copy.max.bytes configuration property.
+// Range: 0 .. 1000000000. Default: 65535.
+func (c *ProducerConfig) MessageCopyMaxBytes(value int) *ProducerConfig {
+	c.set("message.copy.max.bytes", value)
+	return c
+}
+
+// ReceiveMessageMaxBytes sets the receive.message.max.bytes configuration property.
+// Range: 1000 .. 2147483647. Default: 100000000.
+func (c *ProducerConfig) ReceiveMessageMaxBytes(value int) *ProducerConfig {
+	c.set("receive.message.max.bytes", value)
+	return c
+}
+
+// MaxInFlightRequestsPerConnection sets the max.in.flight.requests.per.connection configuration property.
+// Range: 1 .. 1000000. Default: 1000000.
+func (c *ProducerConfig) MaxInFlightRequestsPerConnection(value int) *ProducerConfig {
+	c.set("max.in.flight.requests.per.connection", value)
+	return c
+}
+
+// MaxInFlight sets the max.in.flight configuration property, an alias for max.in.flight.requests.per.connection.
+// Range: 1 .. 1000000. Default: 1000000.
+func (c *ProducerConfig) MaxInFlight(value int) *ProducerConfig {
+	c.set("max.in.flight", value)
+	return c
+}
+
+// MetadataRecoveryStrategy sets the metadata.recovery.strategy configuration property.
+// Default: rebootstrap.
+func (c *ProducerConfig) MetadataRecoveryStrategy(value MetadataRecoveryStrategy) *ProducerConfig {
+	c.set("metadata.recovery.strategy", string(value))
+	return c
+}
+
+// MetadataRecoveryRebootstrapTriggerMs sets the metadata.recovery.rebootstrap.trigger.ms configuration property.
+// Range: 0 .. 2147483647. Default: 300000.
+func (c *ProducerConfig) MetadataRecoveryRebootstrapTriggerMs(value int) *ProducerConfig {
+	c.set("metadata.recovery.rebootstrap.trigger.ms", value)
+	return c
+}
+
+// TopicMetadataRefreshIntervalMs sets the topic.metadata.refresh.interval.ms configuration property.
+// Range: -1 .. 3600000. Default: 300000.
+func (c *ProducerConfig) TopicMetadataRefreshIntervalMs(value int) *ProducerConfig {
+	c.set("topic.metadata.refresh.interval.ms", value)
+	return c
+}
+
+// MetadataMaxAgeMs sets the metadata.max.age.ms configuration property.
+// Range: 1 .. 86400000. Default: 900000.
+func (c *ProducerConfig) MetadataMaxAgeMs(value int) *ProducerConfig {
+	c.set("metadata.max.age.ms", value)
+	return c
+}
+
+// TopicMetadataRefreshFastIntervalMs sets the topic.metadata.refresh.fast.interval.ms configuration property.
+// Range: 1 .. 60000. Default: 100.
+func (c *ProducerConfig) TopicMetadataRefreshFastIntervalMs(value int) *ProducerConfig {
+	c.set("topic.metadata.refresh.fast.interval.ms", value)
+	return c
+}
+
+// TopicMetadataRefreshFastCnt sets the topic.metadata.refresh.fast.cnt configuration property.
+// Range: 0 .. 1000. Default: 10.
+//
+// Deprecated: topic.metadata.refresh.fast.cnt is deprecated by librdkafka.
+func (c *ProducerConfig) TopicMetadataRefreshFastCnt(value int) *ProducerConfig {
+	c.set("topic.metadata.refresh.fast.cnt", value)
+	return c
+}
+
+// TopicMetadataRefreshSparse sets the topic.metadata.refresh.sparse configuration property.
+// Default: true.
+func (c *ProducerConfig) TopicMetadataRefreshSparse(value bool) *ProducerConfig {
+	c.set("topic.metadata.refresh.sparse", value)
+	return c
+}
+
+// TopicMetadataPropagationMaxMs sets the topic.metadata.propagation.max.ms configuration property.
+// Range: 0 .. 3600000. Default: 30000.
+func (c *ProducerConfig) TopicMetadataPropagationMaxMs(value int) *ProducerConfig {
+	c.set("topic.metadata.propagation.max.ms", value)
+	return c
+}
+
+// TopicBlacklist sets the topic.blacklist configuration property.
+func (c *ProducerConfig) TopicBlacklist(value string) *ProducerConfig {
+	c.set("topic.blacklist", value)
+	return c
+}
+
+// Debug sets the debug configuration property.
+func (c *ProducerConfig) Debug(flags ...DebugFlag) *ProducerConfig {
+	c.set("debug", joinFlags(flags))
+	return c
+}
+
+// SocketTimeoutMs sets the socket.timeout.ms configuration property.
+// Range: 10 .. 300000. Default: 60000.
+func (c *ProducerConfig) SocketTimeoutMs(value int) *ProducerConfig {
+	c.set("socket.timeout.ms", value)
+	return c
+}
+
+// SocketBlockingMaxMs sets the socket.blocking.max.ms configuration property.
+// Range: 1 .. 60000. Default: 1000.
+//
+// Deprecated: socket.blocking.max.ms is deprecated by librdkafka.
+func (c *ProducerConfig) SocketBlockingMaxMs(value int) *ProducerConfig {
+	c.set("socket.blocking.max.ms", value)
+	return c
+}
+
+// SocketSendBufferBytes sets the socket.send.buffer.bytes configuration property.
+// Range: 0 .. 100000000. Default: 0.
+func (c *ProducerConfig) SocketSendBufferBytes(value int) *ProducerConfig {
+	c.set("socket.send.buffer.bytes", value)
+	return c
+}
+
+// SocketReceiveBufferBytes sets the socket.receive.buffer.bytes configuration property.
+// Range: 0 .. 100000000. Default: 0.
+func (c *ProducerConfig) SocketReceiveBufferBytes(value int) *ProducerConfig {
+	c.set("socket.receive.buffer.bytes", value)
+	return c
+}
+
+// SocketKeepaliveEnable sets the socket.keepalive.enable configuration property.
+// Default: false.
+func (c *ProducerConfig) SocketKeepaliveEnable(value bool) *ProducerConfig {
+	c.set("socket.keepalive.enable", value)
+	return c
+}
+
+// SocketNagleDisable sets the socket.nagle.disable configuration property.
+// Default: true.
+func (c *ProducerConfig) SocketNagleDisable(value bool) *ProducerConfig {
+	c.set("socket.nagle.disable", value)
+	return c
+}
+
+// SocketMaxFails sets the socket.max.fails configuration property.
+// Range: 0 .. 1000000. Default: 1.
+func (c *ProducerConfig) SocketMaxFails(value int) *ProducerConfig {
+	c.set("socket.max.fails", value)
+	return c
+}
+
+// BrokerAddressTtl sets the broker.address.ttl configuration property.
+// Range: 0 .. 86400000. Default: 1000.
+func (c *ProducerConfig) BrokerAddressTtl(value int) *ProducerConfig {
+	c.set("broker.address.ttl", value)
+	return c
+}
+
+// BrokerAddressFamily sets the broker.address.family configuration property.
+// Default: any.
+func (c *ProducerConfig) BrokerAddressFamily(value BrokerAddressFamily) *ProducerConfig {
+	c.set("broker.address.family", string(value))
+	return c
+}
+
+// SocketConnectionSetupTimeoutMs sets the socket.connection.setup.timeout.ms configuration property.
+// Range: 1000 .. 2147483647. Default: 30000.
+func (c *ProducerConfig) SocketConnectionSetupTimeoutMs(value int) *ProducerConfig {
+	c.set("socket.connection.setup.timeout.ms", value)
+	return c
+}
+
+// ConnectionsMaxIdleMs sets the connections.max.idle.ms configuration property.
+// Range: 0 .. 2147483647. Default: 0.
+func (c *ProducerConfig) ConnectionsMaxIdleMs(value int) *ProducerConfig {
+	c.set("connections.max.idle.ms", value)
+	return c
+}
+
+// ReconnectBackoffJitterMs sets the reconnect.backoff.jitter.ms configuration property.
+// Range: 0 .. 3600000. Default: 0.
+//
+// Deprecated: reconnect.backoff.jitter.ms is deprecated by librdkafka.
+func (c *ProducerConfig) ReconnectBackoffJitterMs(value int) *ProducerConfig {
+	c.set("reconnect.backoff.jitter.ms", value)
+	return c
+}
+
+// ReconnectBackoffMs sets the reconnect.backoff.ms configuration property.
+// Range: 0 .. 3600000. Default: 100.
+func (c *ProducerConfig) ReconnectBackoffMs(value int) *ProducerConfig {
+	c.set("reconnect.backoff.ms", value)
+	return c
+}
+
+// ReconnectBackoffMaxMs sets the reconnect.backoff.max.ms configuration property.
+// Range: 0 .. 3600000. Default: 10000.
+func (c *ProducerConfig) ReconnectBackoffMaxMs(value int) *ProducerConfig {
+	c.set("reconnect.backoff.max.ms", value)
+	return c
+}
+
+// StatisticsIntervalMs sets the statistics.interval.ms configuration property.
+// Range: 0 .. 86400000. Default: 0.
+func (c *ProducerConfig) StatisticsIntervalMs(value int) *ProducerConfig {
+	c.set("statistics.interval.ms", value)
+	return c
+}
+
+// EnabledEvents sets the enabled_events configuration property.
+// Range: 0 .. 2147483647. Default: 0.
+func (c *ProducerConfig) EnabledEvents(value int) *ProducerConfig {
+	c.set("enabled_events", value)
+	return c
+}
+
+// LogLevel sets the log_level configuration property.
+// Range: 0 .. 7. Default: 6.
+func (c *ProducerConfig) LogLevel(value int) *ProducerConfig {
+	c.set("log_level", value)
+	return c
+}
+
+// LogQueue sets the log.queue configuration property.
+// Default: false.
+func (c *ProducerConfig) LogQueue(value bool) *ProducerConfig {
+	c.set("log.queue", value)
+	return c
+}
+
+// LogThreadName sets the log.thread.name configuration property.
+// Default: true.
+func (c *ProducerConfig) LogThreadName(value bool) *ProducerConfig {
+	c.set("log.thread.name", value)
+	return c
+}
+
+// EnableRandomSeed sets the enable.random.seed configuration property.
+// Default: true.
+func (c *ProducerConfig) EnableRandomSeed(value bool) *ProducerConfig {
+	c.set("enable.random.seed", value)
+	return c
+}
+
+// LogConnectionClose sets the log.connection.close configuration property.
+// Default: true.
+func (c *ProducerConfig) LogConnectionClose(value bool) *ProducerConfig {
+	c.set("log.connection.close", value)
+	return c
+}
+
+// InternalTerminationSignal sets the internal.termination.signal configuration property.
+// Range: 0 .. 128. Default: 0.
+func (c *ProducerConfig) InternalTerminationSignal(value int) *ProducerConfig {
+	c.set("internal.termination.signal", value)
+	return c
+}
+
+// ApiVersionRequest sets the api.version.request configuration property.
+// Default: true.
+func (c *ProducerConfig) ApiVersionRequest(value bool) *ProducerConfig {
+	c.set("api.version.request", value)
+	return c
+}
+
+// ApiVersionRequestTimeoutMs sets the api.version.request.timeout.ms configuration property.
+// Range: 1 .. 300000. Default: 10000.
+func (c *ProducerConfig) ApiVersionRequestTimeoutMs(value int) *ProducerConfig {
+	c.set("api.version.request.timeout.ms", value)
+	return c
+}
+
+// ApiVersionFallbackMs sets the api.version.fallback.ms configuration property.
+// Range: 0 .. 604800000. Default: 0.
+func (c *ProducerConfig) ApiVersionFallbackMs(value int) *ProducerConfig {
+	c.set("api.version.fallback.ms", value)
+	return c
+}
+
+// BrokerVersionFallback sets the broker.version.fallback configuration property.
+// Default: 0.10.0.
+func (c *ProducerConfig) BrokerVersionFallback(value string) *ProducerConfig {
+	c.set("broker.version.fallback", value)
+	return c
+}
+
+// AllowAutoCreateTopics sets the allow.auto.create.topics configuration property.
+// Default: false.
+func (c *ProducerConfig) AllowAutoCreateTopics(value bool) *ProducerConfig {
+	c.set("allow.auto.create.topics", value)
+	return c
+}
+
+// SecurityProtocol sets the security.protocol configuration property.
+// Default: plaintext.
+func (c *ProducerConfig) SecurityProtocol(value SecurityProtocol) *ProducerConfig {
+	c.set("security.protocol", string(value))
+	return c
+}
+
+// SslCipherSuites sets the ssl.cipher.suites configuration property.
+func (c *ProducerConfig) SslCipherSuites(value string) *ProducerConfig {
+	c.set("ssl.cipher.suites", value)
+	return c
+}
+
+// SslCurvesList sets the ssl.curves.list configuration property.
+func (c *ProducerConfig) SslCurvesList(value string) *ProducerConfig {
+	c.set("ssl.curves.list", value)
+	return c
+}
+
+// SslSigalgsList sets the ssl.sigalgs.list configuration property.
+func (c *ProducerConfig) SslSigalgsList(value string) *ProducerConfig {
+	c.set("ssl.sigalgs.list", value)
+	return c
+}
+
+// SslKeyLocation sets the ssl.key.location configuration property.
+func (c *ProducerConfig) SslKeyLocation(value string) *ProducerConfig {
+	c.set("ssl.key.location", value)
+	return c
+}
+
+// SslKeyPassword sets the ssl.key.password configuration property.
+func (c *ProducerConfig) SslKeyPassword(value string) *ProducerConfig {
+	c.set("ssl.key.password", value)
+	return c
+}
+
+// SslKeyPem sets the ssl.key.pem configuration property.
+func (c *ProducerConfig) SslKeyPem(value string) *ProducerConfig {
+	c.set("ssl.key.pem", value)
+	return c
+}
+
+// SslCertificateLocation sets the ssl.certificate.location configuration property.
+func (c *ProducerConfig) SslCertificateLocation(value string) *ProducerConfig {
+	c.set("ssl.certificate.location", value)
+	return c
+}
+
+// SslCertificatePem sets the ssl.certificate.pem configuration property.
+func (c *ProducerConfig) SslCertificatePem(value string) *ProducerConfig {
+	c.set("ssl.certificate.pem", value)
+	return c
+}
+
+// SslCaLocation sets the ssl.ca.location configuration property.
+func (c *ProducerConfig) SslCaLocation(value string) *ProducerConfig {
+	c.set("ssl.ca.location", value)
+	return c
+}
+
+// HttpsCaLocation sets the https.ca.location configuration property.
+func (c *ProducerConfig) HttpsCaLocation(value string) *ProducerConfig {
+	c.set("https.ca.location", value)
+	return c
+}
+
+// HttpsCaPem sets the https.ca.pem configuration property.
+func (c *ProducerConfig) HttpsCaPem(value string) *ProducerConfig {
+	c.set("https.ca.pem", value)
+	return c
+}
+
+// SslCaPem sets the ssl.ca.pem configuration property.
+func (c *ProducerConfig) SslCaPem(value string) *ProducerConfig {
+	c.set("ssl.ca.pem", value)
+	return c
+}
+
+// SslCaCertificateStores sets the ssl.ca.certificate.stores configuration property.
+// Default: Root.
+func (c *ProducerConfig) SslCaCertificateStores(value string) *ProducerConfig {
+	c.set("ssl.ca.certificate.stores", value)
+	return c
+}
+
+// SslCrlLocation sets the ssl.crl.location configuration property.
+func (c *ProducerConfig) SslCrlLocation(value string) *ProducerConfig {
+	c.set("ssl.crl.location", value)
+	return c
+}
+
+// SslKeystoreLocation sets the ssl.keystore.location configuration property.
+func (c *ProducerConfig) SslKeystoreLocation(value string) *ProducerConfig {
+	c.set("ssl.keystore.location", value)
+	return c
+}
+
+// SslKeystorePassword sets the ssl.keystore.password configuration property.
+func (c *ProducerConfig) SslKeystorePassword(value string) *ProducerConfig {
+	c.set("ssl.keystore.password", value)
+	return c
+}
+
+// SslProviders sets the ssl.providers configuration property.
+func (c *ProducerConfig) SslProviders(value string) *ProducerConfig {
+	c.set("ssl.providers", value)
+	return c
+}
+
+// SslEngineLocation sets the ssl.engine.location configuration property.
+//
+// Deprecated: ssl.engine.location is deprecated by librdkafka.
+func (c *ProducerConfig) SslEngineLocation(value string) *ProducerConfig {
+	c.set("ssl.engine.location", value)
+	return c
+}
+
+// SslEngineId sets the ssl.engine.id configuration property.
+// Default: dynamic.
+func (c *ProducerConfig) SslEngineId(value string) *ProducerConfig {
+	c.set("ssl.engine.id", value)
+	return c
+}
+
+// EnableSslCertificateVerification sets the enable.ssl.certificate.verification configuration property.
+// Default: true.
+func (c *ProducerConfig) EnableSslCertificateVerification(value bool) *ProducerConfig {
+	c.set("enable.ssl.certificate.verification", value)
+	return c
+}
+
+// SslEndpointIdentificationAlgorithm sets the ssl.endpoint.identification.algorithm configuration property.
+// Default: https.
+func (c *ProducerConfig) SslEndpointIdentificationAlgorithm(value SslEndpointIdentificationAlgorithm) *ProducerConfig {
+	c.set("ssl.endpoint.identification.algorithm", string(value))
+	return c
+}
+
+// SaslMechanisms sets the sasl.mechanisms configuration property.
+// Default: GSSAPI.
+func (c *ProducerConfig) SaslMechanisms(value string) *ProducerConfig {
+	c.set("sasl.mechanisms", value)
+	return c
+}
+
+// SaslMechanism sets the sasl.mechanism configuration property, an alias for sasl.mechanisms.
+// Default: GSSAPI.
+func (c *ProducerConfig) SaslMechanism(value string) *ProducerConfig {
+	c.set("sasl.mechanism", value)
+	return c
+}
+
+// SaslKerberosServiceName sets the sasl.kerberos.service.name configuration property.
+// Default: kafka.
+func (c *ProducerConfig) SaslKerberosServiceName(value string) *ProducerConfig {
+	c.set("sasl.kerberos.service.name", value)
+	return c
+}
+
+// SaslKerberosPrincipal sets the sasl.kerberos.principal configuration property.
+// Default: kafkaclient.
+func (c *ProducerConfig) SaslKerberosPrincipal(value string) *ProducerConfig {
+	c.set("sasl.kerberos.principal", value)
+	return c
+}
+
+// SaslKerberosKinitCmd sets the sasl.kerberos.kinit.cmd configuration property.
+func (c *ProducerConfig) SaslKerberosKinitCmd(value string) *ProducerConfig {
+	c.set("sasl.kerberos.kinit.cmd", value)
+	return c
+}
+
+// SaslKerberosKeytab sets the sasl.kerberos.keytab configuration property.
+func (c *ProducerConfig) SaslKerberosKeytab(value string) *ProducerConfig {
+	c.set("sasl.kerberos.keytab", value)
+	return c
+}
+
+// SaslKerberosMinTimeBeforeRelogin sets the sasl.kerberos.min.time.before.relogin configuration property.
+// Range: 0 .. 86400000. Default: 60000.
+func (c *ProducerConfig) SaslKerberosMinTimeBeforeRelogin(value int) *ProducerConfig {
+	c.set("sasl.kerberos.min.time.before.relogin", value)
+	return c
+}
+
+// SaslUsername sets the sasl.username configuration property.
+func (c *ProducerConfig) SaslUsername(value string) *ProducerConfig {
+	c.set("sasl.username", value)
+	return c
+}
+
+// SaslPassword sets the sasl.password configuration property.
+func (c *ProducerConfig) SaslPassword(value string) *ProducerConfig {
+	c.set("sasl.password", value)
+	return c
+}
+
+// SaslOauthbearerConfig sets the sasl.oauthbearer.config configuration property.
+func (c *ProducerConfig) SaslOauthbearerConfig(value string) *ProducerConfig {
+	c.set("sasl.oauthbearer.config", value)
+	return c
+}
+
+// EnableSaslOauthbearerUnsecureJwt sets the enable.sasl.oauthbearer.unsecure.jwt configuration property.
+// Default: false.
+func (c *ProducerConfig) EnableSaslOauthbearerUnsecureJwt(value bool) *ProducerConfig {
+	c.set("enable.sasl.oauthbearer.unsecure.jwt", value)
+	return c
+}
+
+// SaslOauthbearerMethod sets the sasl.oauthbearer.method configuration property.
+// Default: default.
+func (c *ProducerConfig) SaslOauthbearerMethod(value SaslOauthbearerMethod) *ProducerConfig {
+	c.set("sasl.oauthbearer.method", string(value))
+	return c
+}
+
+// SaslOauthbearerClientId sets the sasl.oauthbearer.client.id configuration property.
+func (c *ProducerConfig) SaslOauthbearerClientId(value string) *ProducerConfig {
+	c.set("sasl.oauthbearer.client.id", value)
+	return c
+}
+
+// SaslOauthbearerClientSecret sets the sasl.oauthbearer.client.secret configuration property.
+func (c *ProducerConfig) SaslOauthbearerClientSecret(value string) *ProducerConfig {
+	c.set("sasl.oauthbearer.client.secret", value)
+	return c
+}
+
+// SaslOauthbearerScope sets the sasl.oauthbearer.scope configuration property.
+func (c *ProducerConfig) SaslOauthbearerScope(value string) *ProducerConfig {
+	c.set("sasl.oauthbearer.scope", value)
+	return c
+}
+
+// SaslOauthbearerExtensions sets the sasl.oauthbearer.extensions configuration property.
+func (c *ProducerConfig) SaslOauthbearerExtensions(value string) *ProducerConfig {
+	c.set("sasl.oauthbearer.extensions", value)
+	return c
+}
+
+// SaslOauthbearerTokenEndpointUrl sets the sasl.oauthbearer.token.endpoint.url configuration property.
+func (c *ProducerConfig) SaslOauthbearerTokenEndpointUrl(value string) *ProducerConfig {
+	c.set("sasl.oauthbearer.token.endpoint.url", value)
+	return c
+}
+
+// PluginLibraryPaths sets the plugin.library.paths configuration property.
+func (c *ProducerConfig) PluginLibraryPaths(value string) *ProducerConfig {
+	c.set("plugin.library.paths", value)
+	return c
+}
+
+// ClientRack sets the client.rack configuration property.
+func (c *ProducerConfig) ClientRack(value string) *ProducerConfig {
+	c.set("client.rack", value)
+	return c
+}
+
+// TransactionalId sets the transactional.id configuration property.
+func (c *ProducerConfig) TransactionalId(value string) *ProducerConfig {
+	c.set("transactional.id", value)
+	return c
+}
+
+// TransactionTimeoutMs sets the transaction.timeout.ms configuration property.
+// Range: 1000 .. 2147483647. Default: 60000.
+func (c *ProducerConfig) TransactionTimeoutMs(value int) *ProducerConfig {
+	c.set("transaction.timeout.ms", value)
+	return c
+}
+
+// EnableIdempotence sets the enable.idempotence configuration property.
+// Default: false.
+func (c *ProducerConfig) EnableIdempotence(value bool) *ProducerConfig {
+	c.set("enable.idempotence", value)
+	return c
+}
+
+// EnableGaplessGuarantee sets the enable.gapless.guarantee configuration property.
+// Default: false.
+func (c *ProducerConfig) EnableGaplessGuarantee(value bool) *ProducerConfig {
+	c.set("enable.gapless.guarantee", value)
+	return c
+}
+
+// QueueBufferingMaxMessages sets the queue.buffering.max.messages configuration property.
+// Range: 0 .. 2147483647. Default: 100000.
+func (c *ProducerConfig) QueueBufferingMaxMessages(value int) *ProducerConfig {
+	c.set("queue.buffering.max.messages", value)
+	return c
+}
+
+// QueueBufferingMaxKbytes sets the queue.buffering.max.kbytes configuration property.
+// Range: 1 .. 2147483647. Default: 1048576.
+func (c *ProducerConfig) QueueBufferingMaxKbytes(value int) *ProducerConfig {
+	c.set("queue.buffering.max.kbytes", value)
+	return c
+}
+
+// QueueBufferingMaxMs sets the queue.buffering.max.ms configuration property.
+// Range: 0 .. 900000. Default: 5.
+func (c *ProducerConfig) QueueBufferingMaxMs(value float64) *ProducerConfig {
+	c.set("queue.buffering.max.ms", value)
+	return c
+}
+
+// LingerMs sets the linger.ms configuration property, an alias for queue.buffering.max.ms.
+// Range: 0 .. 900000. Default: 5.
+func (c *ProducerConfig) LingerMs(value float64) *ProducerConfig {
+	c.set("linger.ms", value)
+	return c
+}
+
+// MessageSendMaxRetries sets the message.send.max.retries configuration property.
+// Range: 0 .. 2147483647. Default: 2147483647.
+func (c *ProducerConfig) MessageSendMaxRetries(value int) *ProducerConfig {
+	c.set("message.send.max.retries", value)
+	return c
+}
+
+// Retries sets the retries configuration property, an alias for message.send.max.retries.
+// Range: 0 .. 2147483647. Default: 2147483647.
+func (c *ProducerConfig) Retries(value int) *ProducerConfig {
+	c.set("retries", value)
+	return c
+}
+
+// RetryBackoffMs sets the retry.backoff.ms configuration property.
+// Range: 1 .. 300000. Default: 100.
+func (c *ProducerConfig) RetryBackoffMs(value int) *ProducerConfig {
+	c.set("retry.backoff.ms", value)
+	return c
+}
+
+// RetryBackoffMaxMs sets the retry.backoff.max.ms configuration property.
+// Range: 1 .. 300000. Default: 1000.
+func (c *ProducerConfig) RetryBackoffMaxMs(value int) *ProducerConfig {
+	c.set("retry.backoff.max.ms", value)
+	return c
+}
+
+// QueueBufferingBackpressureThreshold sets the queue.buffering.backpressure.threshold configuration property.
+// Range: 1 .. 1000000. Default: 1.
+func (c *ProducerConfig) QueueBufferingBackpressureThreshold(value int) *ProducerConfig {
+	c.set("queue.buffering.backpressure.threshold", value)
+	return c
+}
+
+// CompressionCodec sets the compression.codec configuration property.
+// Default: none.
+func (c *ProducerConfig) CompressionCodec(value CompressionCodec) *ProducerConfig {
+	c.set("compression.codec", string(value))
+	return c
+}
+
+// CompressionType sets the compression.type configuration property, an alias for compression.codec.
+// Default: none.
+func (c *ProducerConfig) CompressionType(value CompressionCodec) *ProducerConfig {
+	c.set("compression.type", string(value))
+	return c
+}
+
+// BatchNumMessages sets the batch.num.messages configuration property.
+// Range: 1 .. 1000000. Default: 10000.
+func (c *ProducerConfig) BatchNumMessages(value int) *ProducerConfig {
+	c.set("batch.num.messages", value)
+	return c
+}
+
+// BatchSize sets the batch.size configuration property.
+// Range: 1 .. 2147483647. Default: 1000000.
+func (c *ProducerConfig) BatchSize(value int) *ProducerConfig {
+	c.set("batch.size", value)
+	return c
+}
+
+// DeliveryReportOnlyError sets the delivery.report.only.error configuration property.
+// Default: false.
+func (c *ProducerConfig) DeliveryReportOnlyError(value bool) *ProducerConfig {
+	c.set("delivery.report.only.error", value)
+	return c
+}
+
+// StickyPartitioningLingerMs sets the sticky.partitioning.linger.ms configuration property.
+// Range: 0 .. 900000. Default: 10.
+func (c *ProducerConfig) StickyPartitioningLingerMs(value int) *ProducerConfig {
+	c.set("sticky.partitioning.linger.ms", value)
+	return c
+}
+
+// ClientDnsLookup sets the client.dns.lookup configuration property.
+// Default: use_all_dns_ips.
+func (c *ProducerConfig) ClientDnsLookup(value ClientDnsLookup) *ProducerConfig {
+	c.set("client.dns.lookup", string(value))
+	return c
+}
+
+// EnableMetricsPush sets the enable.metrics.push configuration property.
+// Default: true.
+func (c *ProducerConfig) EnableMetricsPush(value bool) *ProducerConfig {
+	c.set("enable.metrics.push", value)
+	return c
+}
+
+// RequestRequiredAcks sets the request.required.acks configuration property.
+// Range: -1 .. 1000. Default: -1.
+func (c *ProducerConfig) RequestRequiredAcks(value int) *ProducerConfig {
+	c.set("request.required.acks", value)
+	return c
+}
+
+// Acks sets the acks configuration property, an alias for request.required.acks.
+// Range: -1 .. 1000. Default: -1.
+func (c *ProducerConfig) Acks(value int) *ProducerConfig {
+	c.set("acks", value)
+	return c
+}
+
+// RequestTimeoutMs sets the request.timeout.ms configuration property.
+// Range: 1 .. 900000. Default: 30000.
+func (c *ProducerConfig) RequestTimeoutMs(value int) *ProducerConfig {
+	c.set("request.timeout.ms", value)
+	return c
+}
+
+// MessageTimeoutMs sets the message.timeout.ms configuration property.
+// Range: 0 .. 2147483647. Default: 300000.
+func (c *ProducerConfig) MessageTimeoutMs(value int) *ProducerConfig {
+	c.set("message.timeout.ms", value)
+	return c
+}
+
+// DeliveryTimeoutMs sets the delivery.timeout.ms configuration property, an alias for message.timeout.ms.
+// Range: 0 .. 2147483647. Default: 300000.
+func (c *ProducerConfig) DeliveryTimeoutMs(value int) *ProducerConfig {
+	c.set("delivery.timeout.ms", value)
+	return c
+}
+
+// QueuingStrategy sets the queuing.strategy configuration property.
+// Default: fifo.
+//
+// Deprecated: queuing.strategy is deprecated by librdkafka.
+func (c *ProducerConfig) QueuingStrategy(value QueuingStrategy) *ProducerConfig {
+	c.set("queuing.strategy", string(value))
+	return c
+}
+
+// ProduceOffsetReport sets the produce.offset.report configuration property.
+// Default: false.
+//
+// Deprecated: produce.offset.report is deprecated by librdkafka.
+func (c *ProducerConfig) ProduceOffsetReport(value bool) *ProducerConfig {
+	c.set("produce.offset.report", value)
+	return c
+}
+
+// Partitioner sets the partitioner configuration property.
+// Default: consistent_random.
+func (c *ProducerConfig) Partitioner(value string) *ProducerConfig {
+	c.set("partitioner", value)
+	return c
+}
+
+// CompressionLevel sets the compression.level configuration property.
+// Range: -1 .. 12. Default: -1.
+func (c *ProducerConfig) CompressionLevel(value int) *ProducerConfig {
+	c.set("compression.level", value)
+	return c
+}
+
+// BuiltinFeatures sets the builtin.features configuration property.
+func (c *ConsumerConfig) BuiltinFeatures(flags ...BuiltinFeaturesFlag) *ConsumerConfig {
+	c.set("builtin.features", joinFlags(flags))
+	return c
+}
+
+// ClientId sets the client.id configuration property.
+// Default: rdkafka.
+func (c *ConsumerConfig) ClientId(value string) *ConsumerConfig {
+	c.set("client.id", value)
+	return c
+}
+
+// ClientSoftwareName sets the client.software.name configuration property.
+// Default: librdkafka.
+func (c *ConsumerConfig) ClientSoftwareName(value string) *ConsumerConfig {
+	c.set("client.software.name", value)
+	return c
+}
+
+// ClientSoftwareVersion sets the client.software.version configuration property.
+func (c *ConsumerConfig) ClientSoftwareVersion(value string) *ConsumerConfig {
+	c.set("client.software.version", value)
+	return c
+}
+
+// MetadataBrokerList sets the metadata.broker.list configuration property.
+func (c *ConsumerConfig) MetadataBrokerList(value string) *ConsumerConfig {
+	c.set("metadata.broker.list", value)
+	return c
+}
+
+// BootstrapServers sets the bootstrap.servers configuration property, an alias for metadata.broker.list.
+func (c *ConsumerConfig) BootstrapServers(value string) *ConsumerConfig {
+	c.set("bootstrap.servers", value)
+	return c
+}
+
+// MessageMaxBytes sets the message.max.bytes configuration property.
+// Range: 1000 .. 1000000000. Default: 1000000.
+func (c *ConsumerConfig) MessageMaxBytes(value int) *ConsumerConfig {
+	c.set("message.max.bytes", value)
+	return c
+}
+
+// MessageCopyMaxBytes sets the message.copy.max.bytes configuration property.
+// Range: 0 .. 1000000000. Default: 65535.
+func (c *ConsumerConfig) MessageCopyMaxBytes(value int) *ConsumerConfig {
+	c.set("message.copy.max.bytes", value)
+	return c
+}
+
+// ReceiveMessageMaxBytes sets the receive.message.max.bytes configuration property.
+// Range: 1000 .. 2147483647. Default: 100000000.
+func (c *ConsumerConfig) ReceiveMessageMaxBytes(value int) *ConsumerConfig {
+	c.set("receive.message.max.bytes", value)
+	return c
+}
+
+// MaxInFlightRequestsPerConnection sets the max.in.flight.requests.per.connection configuration property.
+// Range: 1 .. 1000000. Default: 1000000.
+func (c *ConsumerConfig) MaxInFlightRequestsPerConnection(value int) *ConsumerConfig {
+	c.set("max.in.flight.requests.per.connection", value)
+	return c
+}
+
+// MaxInFlight sets the max.in.flight configuration property, an alias for max.in.flight.requests.per.connection.
+// Range: 1 .. 1000000. Default: 1000000.
+func (c *ConsumerConfig) MaxInFlight(value int) *ConsumerConfig {
+	c.set("max.in.flight", value)
+	return c
+}
+
+// MetadataRecoveryStrategy sets the metadata.recovery.strategy configuration property.
+// Default: rebootstrap.
+func (c *ConsumerConfig) MetadataRecoveryStrategy(value MetadataRecoveryStrategy) *ConsumerConfig {
+	c.set("metadata.recovery.strategy", string(value))
+	return c
+}
+
+// MetadataRecoveryRebootstrapTriggerMs sets the metadata.recovery.rebootstrap.trigger.ms configuration property.
+// Range: 0 .. 2147483647. Default: 300000.
+func (c *ConsumerConfig) MetadataRecoveryRebootstrapTriggerMs(value int) *ConsumerConfig {
+	c.set("metadata.recovery.rebootstrap.trigger.ms", value)
+	return c
+}
+
+// TopicMetadataRefreshIntervalMs sets the topic.metadata.refresh.interval.ms configuration property.
+// Range: -1 .. 3600000. Default: 300000.
+func (c *ConsumerConfig) TopicMetadataRefreshIntervalMs(value int) *ConsumerConfig {
+	c.set("topic.metadata.refresh.interval.ms", value)
+	return c
+}
+
+// MetadataMaxAgeMs sets the metadata.max.age.ms configuration property.
+// Range: 1 .. 86400000. Default: 900000.
+func (c *ConsumerConfig) MetadataMaxAgeMs(value int) *ConsumerConfig {
+	c.set("metadata.max.age.ms", value)
+	return c
+}
+
+// TopicMetadataRefreshFastIntervalMs sets the topic.metadata.refresh.fast.interval.ms configuration property.
+// Range: 1 .. 60000. Default: 100.
+func (c *ConsumerConfig) TopicMetadataRefreshFastIntervalMs(value int) *ConsumerConfig {
+	c.set("topic.metadata.refresh.fast.interval.ms", value)
+	return c
+}
+
+// TopicMetadataRefreshFastCnt sets the topic.metadata.refresh.fast.cnt configuration property.
+// Range: 0 .. 1000. Default: 10.
+//
+// Deprecated: topic.metadata.refresh.fast.cnt is deprecated by librdkafka.
+func (c *ConsumerConfig) TopicMetadataRefreshFastCnt(value int) *ConsumerConfig {
+	c.set("topic.metadata.refresh.fast.cnt", value)
+	return c
+}
+
+// TopicMetadataRefreshSparse sets the topic.metadata.refresh.sparse configuration property.
+// Default: true.
+func (c *ConsumerConfig) TopicMetadataRefreshSparse(value bool) *ConsumerConfig {
+	c.set("topic.metadata.refresh.sparse", value)
+	return c
+}
+
+// TopicMetadataPropagationMaxMs sets the topic.metadata.propagation.max.ms configuration property.
+// Range: 0 .. 3600000. Default: 30000.
+func (c *ConsumerConfig) TopicMetadataPropagationMaxMs(value int) *ConsumerConfig {
+	c.set("topic.metadata.propagation.max.ms", value)
+	return c
+}
+
+// TopicBlacklist sets the topic.blacklist configuration property.
+func (c *ConsumerConfig) TopicBlacklist(value string) *ConsumerConfig {
+	c.set("topic.blacklist", value)
+	return c
+}
+
+// Debug sets the debug configuration property.
+func (c *ConsumerConfig) Debug(flags ...DebugFlag) *ConsumerConfig {
+	c.set("debug", joinFlags(flags))
+	return c
+}
+
+// SocketTimeoutMs sets the socket.timeout.ms configuration property.
+// Range: 10 .. 300000. Default: 60000.
+func (c *ConsumerConfig) SocketTimeoutMs(value int) *ConsumerConfig {
+	c.set("socket.timeout.ms", value)
+	return c
+}
+
+// SocketBlockingMaxMs sets the socket.blocking.max.ms configuration property.
+// Range: 1 .. 60000. Default: 1000.
+//
+// Deprecated: socket.blocking.max.ms is deprecated by librdkafka.
+func (c *ConsumerConfig) SocketBlockingMaxMs(value int) *ConsumerConfig {
+	c.set("socket.blocking.max.ms", value)
+	return c
+}
+
+// SocketSendBufferBytes sets the socket.send.buffer.bytes configuration property.
+// Range: 0 .. 100000000. Default: 0.
+func (c *ConsumerConfig) SocketSendBufferBytes(value int) *ConsumerConfig {
+	c.set("socket.send.buffer.bytes", value)
+	return c
+}
+
+// SocketReceiveBufferBytes sets the socket.receive.buffer.bytes configuration property.
+// Range: 0 .. 100000000. Default: 0.
+func (c *ConsumerConfig) SocketReceiveBufferBytes(value int) *ConsumerConfig {
+	c.set("socket.receive.buffer.bytes", value)
+	return c
+}
+
+// SocketKeepaliveEnable sets the socket.keepalive.enable configuration property.
+// Default: false.
+func (c *ConsumerConfig) SocketKeepaliveEnable(value bool) *ConsumerConfig {
+	c.set("socket.keepalive.enable", value)
+	return c
+}
+
+// SocketNagleDisable sets the socket.nagle.disable configuration property.
+// Default: true.
+func (c *ConsumerConfig) SocketNagleDisable(value bool) *ConsumerConfig {
+	c.set("socket.nagle.disable", value)
+	return c
+}
+
+// SocketMaxFails sets the socket.max.fails configuration property.
+// Range: 0 .. 1000000. Default: 1.
+func (c *ConsumerConfig) SocketMaxFails(value int) *ConsumerConfig {
+	c.set("socket.max.fails", value)
+	return c
+}
+
+// BrokerAddressTtl sets the broker.address.ttl configuration property.
+// Range: 0 .. 86400000. Default: 1000.
+func (c *ConsumerConfig) BrokerAddressTtl(value int) *ConsumerConfig {
+	c.set("broker.address.ttl", value)
+	return c
+}
+
+// BrokerAddressFamily sets the broker.address.family configuration property.
+// Default: any.
+func (c *ConsumerConfig) BrokerAddressFamily(value BrokerAddressFamily) *ConsumerConfig {
+	c.set("broker.address.family", string(value))
+	return c
+}
+
+// SocketConnectionSetupTimeoutMs sets the socket.connection.setup.timeout.ms configuration property.
+// Range: 1000 .. 2147483647. Default: 30000.
+func (c *ConsumerConfig) SocketConnectionSetupTimeoutMs(value int) *ConsumerConfig {
+	c.set("socket.connection.setup.timeout.ms", value)
+	return c
+}
+
+// ConnectionsMaxIdleMs sets the connections.max.idle.ms configuration property.
+// Range: 0 .. 2147483647. Default: 0.
+func (c *ConsumerConfig) ConnectionsMaxIdleMs(value int) *ConsumerConfig {
+	c.set("connections.max.idle.ms", value)
+	return c
+}
+
+// ReconnectBackoffJitterMs sets the reconnect.backoff.jitter.ms configuration property.
+// Range: 0 .. 3600000. Default: 0.
+//
+// Deprecated: reconnect.backoff.jitter.ms is deprecated by librdkafka.
+func (c *ConsumerConfig) ReconnectBackoffJitterMs(value int) *ConsumerConfig {
+	c.set("reconnect.backoff.jitter.ms", value)
+	return c
+}
+
+// ReconnectBackoffMs sets the reconnect.backoff.ms configuration property.
+// Range: 0 .. 3600000. Default: 100.
+func (c *ConsumerConfig) ReconnectBackoffMs(value int) *ConsumerConfig {
+	c.set("reconnect.backoff.ms", value)
+	return c
+}
+
+// ReconnectBackoffMaxMs sets the reconnect.backoff.max.ms configuration property.
+// Range: 0 .. 3600000. Default: 10000.
+func (c *ConsumerConfig) ReconnectBackoffMaxMs(value int) *ConsumerConfig {
+	c.set("reconnect.backoff.max.ms", value)
+	return c
+}
+
+// StatisticsIntervalMs sets the statistics.interval.ms configuration property.
+// Range: 0 .. 86400000. Default: 0.
+func (c *ConsumerConfig) StatisticsIntervalMs(value int) *ConsumerConfig {
+	c.set("statistics.interval.ms", value)
+	return c
+}
+
+// EnabledEvents sets the enabled_events configuration property.
+// Range: 0 .. 2147483647. Default: 0.
+func (c *ConsumerConfig) EnabledEvents(value int) *ConsumerConfig {
+	c.set("enabled_events", value)
+	return c
+}
+
+// LogLevel sets the log_level configuration property.
+// Range: 0 .. 7. Default: 6.
+func (c *ConsumerConfig) LogLevel(value int) *ConsumerConfig {
+	c.set("log_level", value)
+	return c
+}
+
+// LogQueue sets the log.queue configuration property.
+// Default: false.
+func (c *ConsumerConfig) LogQueue(value bool) *ConsumerConfig {
+	c.set("log.queue", value)
+	return c
+}
+
+// LogThreadName sets the log.thread.name configuration property.
+// Default: true.
+func (c *ConsumerConfig) LogThreadName(value bool) *ConsumerConfig {
+	c.set("log.thread.name", value)
+	return c
+}
+
+// EnableRandomSeed sets the enable.random.seed configuration property.
+// Default: true.
+func (c *ConsumerConfig) EnableRandomSeed(value bool) *ConsumerConfig {
+	c.set("enable.random.seed", value)
+	return c
+}
+
+// LogConnectionClose sets the log.connection.close configuration property.
+// Default: true.
+func (c *ConsumerConfig) LogConnectionClose(value bool) *ConsumerConfig {
+	c.set("log.connection.close", value)
+	return c
+}
+
+// InternalTerminationSignal sets the internal.termination.signal configuration property.
+// Range: 0 .. 128. Default: 0.
+func (c *ConsumerConfig) InternalTerminationSignal(value int) *ConsumerConfig {
+	c.set("internal.termination.signal", value)
+	return c
+}
+
+// ApiVersionRequest sets the api.version.request configuration property.
+// Default: true.
+func (c *ConsumerConfig) ApiVersionRequest(value bool) *ConsumerConfig {
+	c.set("api.version.request", value)
+	return c
+}
+
+// ApiVersionRequestTimeoutMs sets the api.version.request.timeout.ms configuration property.
+// Range: 1 .. 300000. Default: 10000.
+func (c *ConsumerConfig) ApiVersionRequestTimeoutMs(value int) *ConsumerConfig {
+	c.set("api.version.request.timeout.ms", value)
+	return c
+}
+
+// ApiVersionFallbackMs sets the api.version.fallback.ms configuration property.
+// Range: 0 .. 604800000. Default: 0.
+func (c *ConsumerConfig) ApiVersionFallbackMs(value int) *ConsumerConfig {
+	c.set("api.version.fallback.ms", value)
+	return c
+}
+
+// BrokerVersionFallback sets the broker.version.fallback configuration property.
+// Default: 0.10.0.
+func (c *ConsumerConfig) BrokerVersionFallback(value string) *ConsumerConfig {
+	c.set("broker.version.fallback", value)
+	return c
+}
+
+// AllowAutoCreateTopics sets the allow.auto.create.topics configuration property.
+// Default: false.
+func (c *ConsumerConfig) AllowAutoCreateTopics(value bool) *ConsumerConfig {
+	c.set("allow.auto.create.topics", value)
+	return c
+}
+
+// SecurityProtocol sets the security.protocol configuration property.
+// Default: plaintext.
+func (c *ConsumerConfig) SecurityProtocol(value SecurityProtocol) *ConsumerConfig {
+	c.set("security.protocol", string(value))
+	return c
+}
+
+// SslCipherSuites sets the ssl.cipher.suites configuration property.
+func (c *ConsumerConfig) SslCipherSuites(value string) *ConsumerConfig {
+	c.set("ssl.cipher.suites", value)
+	return c
+}
+
+// SslCurvesList sets the ssl.curves.list configuration property.
+func (c *ConsumerConfig) SslCurvesList(value string) *ConsumerConfig {
+	c.set("ssl.curves.list", value)
+	return c
+}
+
+// SslSigalgsList sets the ssl.sigalgs.list configuration property.
+func (c *ConsumerConfig) SslSigalgsList(value string) *ConsumerConfig {
+	c.set("ssl.sigalgs.list", value)
+	return c
+}
+
+// SslKeyLocation sets the ssl.key.location configuration property.
+func (c *ConsumerConfig) SslKeyLocation(value string) *ConsumerConfig {
+	c.set("ssl.key.location", value)
+	return c
+}
+
+// SslKeyPassword sets the ssl.key.password configuration property.
+func (c *ConsumerConfig) SslKeyPassword(value string) *ConsumerConfig {
+	c.set("ssl.key.password", value)
+	return c
+}
+
+// SslKeyPem sets the ssl.key.pem configuration property.
+func (c *ConsumerConfig) SslKeyPem(value string) *ConsumerConfig {
+	c.set("ssl.key.pem", value)
+	return c
+}
+
+// SslCertificateLocation sets the ssl.certificate.location configuration property.
+func (c *ConsumerConfig) SslCertificateLocation(value string) *ConsumerConfig {
+	c.set("ssl.certificate.location", value)
+	return c
+}
+
+// SslCertificatePem sets the ssl.certificate.pem configuration property.
+func (c *ConsumerConfig) SslCertificatePem(value string) *ConsumerConfig {
+	c.set("ssl.certificate.pem", value)
+	return c
+}
+
+// SslCaLocation sets the ssl.ca.location configuration property.
+func (c *ConsumerConfig) SslCaLocation(value string) *ConsumerConfig {
+	c.set("ssl.ca.location", value)
+	return c
+}
+
+// HttpsCaLocation sets the https.ca.location configuration property.
+func (c *ConsumerConfig) HttpsCaLocation(value string) *ConsumerConfig {
+	c.set("https.ca.location", value)
+	return c
+}
+
+// HttpsCaPem sets the https.ca.pem configuration property.
+func (c *ConsumerConfig) HttpsCaPem(value string) *ConsumerConfig {
+	c.set("https.ca.pem", value)
+	return c
+}
+
+// SslCaPem sets the ssl.ca.pem configuration property.
+func (c *ConsumerConfig) SslCaPem(value string) *ConsumerConfig {
+	c.set("ssl.ca.pem", value)
+	return c
+}
+
+// SslCaCertificateStores sets the ssl.ca.certificate.stores configuration property.
+// Default: Root.
+func (c *ConsumerConfig) SslCaCertificateStores(value string) *ConsumerConfig {
+	c.set("ssl.ca.certificate.stores", value)
+	return c
+}
+
+// SslCrlLocation sets the ssl.crl.location configuration property.
+func (c *ConsumerConfig) SslCrlLocation(value string) *ConsumerConfig {
+	c.set("ssl.crl.location", value)
+	return c
+}
+
+// SslKeystoreLocation sets the ssl.keystore.location configuration property.
+func (c *ConsumerConfig) SslKeystoreLocation(value string) *ConsumerConfig {
+	c.set("ssl.keystore.location", value)
+	return c
+}
+
+// SslKeystorePassword sets the ssl.keystore.password configuration property.
+func (c *ConsumerConfig) SslKeystorePassword(value string) *ConsumerConfig {
+	c.set("ssl.keystore.password", value)
+	return c
+}
+
+// SslProviders sets the ssl.providers configuration property.
+func (c *ConsumerConfig) SslProviders(value string) *ConsumerConfig {
+	c.set("ssl.providers", value)
+	return c
+}
+
+// SslEngineLocation sets the ssl.engine.location configuration property.
+//
+// Deprecated: ssl.engine.location is deprecated by librdkafka.
+func (c *ConsumerConfig) SslEngineLocation(value string) *ConsumerConfig {
+	c.set("ssl.engine.location", value)
+	return c
+}
+
+// SslEngineId sets the ssl.engine.id configuration property.
+// Default: dynamic.
+func (c *ConsumerConfig) SslEngineId(value string) *ConsumerConfig {
+	c.set("ssl.engine.id", value)
+	return c
+}
+
+// EnableSslCertificateVerification sets the enable.ssl.certificate.verification configuration property.
+// Default: true.
+func (c *ConsumerConfig) EnableSslCertificateVerification(value bool) *ConsumerConfig {
+	c.set("enable.ssl.certificate.verification", value)
+	return c
+}
+
+// SslEndpointIdentificationAlgorithm sets the ssl.endpoint.identification.algorithm configuration property.
+// Default: https.
+func (c *ConsumerConfig) SslEndpointIdentificationAlgorithm(value SslEndpointIdentificationAlgorithm) *ConsumerConfig {
+	c.set("ssl.endpoint.identification.algorithm", string(value))
+	return c
+}
+
+// SaslMechanisms sets the sasl.mechanisms configuration property.
+// Default: GSSAPI.
+func (c *ConsumerConfig) SaslMechanisms(value string) *ConsumerConfig {
+	c.set("sasl.mechanisms", value)
+	return c
+}
+
+// SaslMechanism sets the sasl.mechanism configuration property, an alias for sasl.mechanisms.
+// Default: GSSAPI.
+func (c *ConsumerConfig) SaslMechanism(value string) *ConsumerConfig {
+	c.set("sasl.mechanism", value)
+	return c
+}
+
+// SaslKerberosServiceName sets the sasl.kerberos.service.name configuration property.
+// Default: kafka.
+func (c *ConsumerConfig) SaslKerberosServiceName(value string) *ConsumerConfig {
+	c.set("sasl.kerberos.service.name", value)
+	return c
+}
+
+// SaslKerberosPrincipal sets the sasl.kerberos.principal configuration property.
+// Default: kafkaclient.
+func (c *ConsumerConfig) SaslKerberosPrincipal(value string) *ConsumerConfig {
+	c.set("sasl.kerberos.principal", value)
+	return c
+}
+
+// SaslKerberosKinitCmd sets the sasl.kerberos.kinit.cmd configuration property.
+func (c *ConsumerConfig) SaslKerberosKinitCmd(value string) *ConsumerConfig {
+	c.set("sasl.kerberos.kinit.cmd", value)
+	return c
+}
+
+// SaslKerberosKeytab sets the sasl.kerberos.keytab configuration property.
+func (c *ConsumerConfig) SaslKerberosKeytab(value string) *ConsumerConfig {
+	c.set("sasl.kerberos.keytab", value)
+	return c
+}
+
+// SaslKerberosMinTimeBeforeRelogin sets the sasl.kerberos.min.time.before.relogin configuration property.
+// Range: 0 .. 86400000. Default: 60000.
+func (c *ConsumerConfig) SaslKerberosMinTimeBeforeRelogin(value int) *ConsumerConfig {
+	c.set("sasl.kerberos.min.time.before.relogin", value)
+	return c
+}
+
+// SaslUsername sets the sasl.username configuration property.
+func (c *ConsumerConfig) SaslUsername(value string) *ConsumerConfig {
+	c.set("sasl.username", value)
+	return c
+}
+
+// SaslPassword sets the sasl.password configuration property.
+func (c *ConsumerConfig) SaslPassword(value string) *ConsumerConfig {
+	c.set("sasl.password", value)
+	return c
+}
+
+// SaslOauthbearerConfig sets the sasl.oauthbearer.config configuration property.
+func (c *ConsumerConfig) SaslOauthbearerConfig(value string) *ConsumerConfig {
+	c.set("sasl.oauthbearer.config", value)
+	return c
+}
+
+// EnableSaslOauthbearerUnsecureJwt sets the enable.sasl.oauthbearer.unsecure.jwt configuration property.
+// Default: false.
+func (c *ConsumerConfig) EnableSaslOauthbearerUnsecureJwt(value bool) *ConsumerConfig {
+	c.set("enable.sasl.oauthbearer.unsecure.jwt", value)
+	return c
+}
+
+// SaslOauthbearerMethod sets the sasl.oauthbearer.method configuration property.
+// Default: default.
+func (c *ConsumerConfig) SaslOauthbearerMethod(value SaslOauthbearerMethod) *ConsumerConfig {
+	c.set("sasl.oauthbearer.method", string(value))
+	return c
+}
+
+// SaslOauthbearerClientId sets the sasl.oauthbearer.client.id configuration property.
+func (c *ConsumerConfig) SaslOauthbearerClientId(value string) *ConsumerConfig {
+	c.set("sasl.oauthbearer.client.id", value)
+	return c
+}
+
+// SaslOauthbearerClientSecret sets the sasl.oauthbearer.client.secret configuration property.
+func (c *ConsumerConfig) SaslOauthbearerClientSecret(value string) *ConsumerConfig {
+	c.set("sasl.oauthbearer.client.secret", value)
+	return c
+}
+
+// SaslOauthbearerScope sets the sasl.oauthbearer.scope configuration property.
+func (c *ConsumerConfig) SaslOauthbearerScope(value string) *ConsumerConfig {
+	c.set("sasl.oauthbearer.scope", value)
+	return c
+}
+
+// SaslOauthbearerExtensions sets the sasl.oauthbearer.extensions configuration property.
+func (c *ConsumerConfig) SaslOauthbearerExtensions(value string) *ConsumerConfig {
+	c.set("sasl.oauthbearer.extensions", value)
+	return c
+}
+
+// SaslOauthbearerTokenEndpointUrl sets the sasl.oauthbearer.token.endpoint.url configuration property.
+func (c *ConsumerConfig) SaslOauthbearerTokenEndpointUrl(value string) *ConsumerConfig {
+	c.set("sasl.oauthbearer.token.endpoint.url", value)
+	return c
+}
+
+// PluginLibraryPaths sets the plugin.library.paths configuration property.
+func (c *ConsumerConfig) PluginLibraryPaths(value string) *ConsumerConfig {
+	c.set("plugin.library.paths", value)
+	return c
+}
+
+// GroupId sets the group.id configuration property.
+func (c *ConsumerConfig) GroupId(value string) *ConsumerConfig {
+	c.set("group.id", value)
+	return c
+}
+
+// GroupInstanceId sets the group.instance.id configuration property.
+func (c *ConsumerConfig) GroupInstanceId(value string) *ConsumerConfig {
+	c.set("group.instance.id", value)
+	return c
+}
+
+// PartitionAssignmentStrategy sets the partition.assignment.strategy configuration property.
+// Default: range,roundrobin.
+func (c *ConsumerConfig) PartitionAssignmentStrategy(value string) *ConsumerConfig {
+	c.set("partition.assignment.strategy", value)
+	return c
+}
+
+// SessionTimeoutMs sets the session.timeout.ms configuration property.
+// Range: 1 .. 3600000. Default: 45000.
+func (c *ConsumerConfig) SessionTimeoutMs(value int) *ConsumerConfig {
+	c.set("session.timeout.ms", value)
+	return c
+}
+
+// HeartbeatIntervalMs sets the heartbeat.interval.ms configuration property.
+// Range: 1 .. 3600000. Default: 3000.
+func (c *ConsumerConfig) HeartbeatIntervalMs(value int) *ConsumerConfig {
+	c.set("heartbeat.interval.ms", value)
+	return c
+}
+
+// GroupProtocolType sets the group.protocol.type configuration property.
+// Default: consumer.
+func (c *ConsumerConfig) GroupProtocolType(value string) *ConsumerConfig {
+	c.set("group.protocol.type", value)
+	return c
+}
+
+// GroupProtocol sets the group.protocol configuration property.
+// Default: classic.
+func (c *ConsumerConfig) GroupProtocol(value GroupProtocol) *ConsumerConfig {
+	c.set("group.protocol", string(value))
+	return c
+}
+
+// GroupRemoteAssignor sets the group.remote.assignor configuration property.
+func (c *ConsumerConfig) GroupRemoteAssignor(value string) *ConsumerConfig {
+	c.set("group.remote.assignor", value)
+	return c
+}
+
+// CoordinatorQueryIntervalMs sets the coordinator.query.interval.ms configuration property.
+// Range: 1 .. 3600000. Default: 600000.
+func (c *ConsumerConfig) CoordinatorQueryIntervalMs(value int) *ConsumerConfig {
+	c.set("coordinator.query.interval.ms", value)
+	return c
+}
+
+// MaxPollIntervalMs sets the max.poll.interval.ms configuration property.
+// Range: 1 .. 86400000. Default: 300000.
+func (c *ConsumerConfig) MaxPollIntervalMs(value int) *ConsumerConfig {
+	c.set("max.poll.interval.ms", value)
+	return c
+}
+
+// EnableAutoCommit sets the enable.auto.commit configuration property.
+// Default: true.
+func (c *ConsumerConfig) EnableAutoCommit(value bool) *ConsumerConfig {
+	c.set("enable.auto.commit", value)
+	return c
+}
+
+// AutoCommitIntervalMs sets the auto.commit.interval.ms configuration property.
+// Range: 0 .. 86400000. Default: 5000.
+func (c *ConsumerConfig) AutoCommitIntervalMs(value int) *ConsumerConfig {
+	c.set("auto.commit.interval.ms", value)
+	return c
+}
+
+// EnableAutoOffsetStore sets the enable.auto.offset.store configuration property.
+// Default: true.
+func (c *ConsumerConfig) EnableAutoOffsetStore(value bool) *ConsumerConfig {
+	c.set("enable.auto.offset.store", value)
+	return c
+}
+
+// QueuedMinMessages sets the queued.min.messages configuration property.
+// Range: 1 .. 10000000. Default: 100000.
+func (c *ConsumerConfig) QueuedMinMessages(value int) *ConsumerConfig {
+	c.set("queued.min.messages", value)
+	return c
+}
+
+// QueuedMaxMessagesKbytes sets the queued.max.messages.kbytes configuration property.
+// Range: 1 .. 2097151. Default: 65536.
+func (c *ConsumerConfig) QueuedMaxMessagesKbytes(value int) *ConsumerConfig {
+	c.set("queued.max.messages.kbytes", value)
+	return c
+}
+
+// FetchWaitMaxMs sets the fetch.wait.max.ms configuration property.
+// Range: 0 .. 300000. Default: 500.
+func (c *ConsumerConfig) FetchWaitMaxMs(value int) *ConsumerConfig {
+	c.set("fetch.wait.max.ms", value)
+	return c
+}
+
+// FetchQueueBackoffMs sets the fetch.queue.backoff.ms configuration property.
+// Range: 0 .. 300000. Default: 1000.
+func (c *ConsumerConfig) FetchQueueBackoffMs(value int) *ConsumerConfig {
+	c.set("fetch.queue.backoff.ms", value)
+	return c
+}
+
+// FetchMessageMaxBytes sets the fetch.message.max.bytes configuration property.
+// Range: 1 .. 1000000000. Default: 1048576.
+func (c *ConsumerConfig) FetchMessageMaxBytes(value int) *ConsumerConfig {
+	c.set("fetch.message.max.bytes", value)
+	return c
+}
+
+// MaxPartitionFetchBytes sets the max.partition.fetch.bytes configuration property, an alias for fetch.message.max.bytes.
+// Range: 1 .. 1000000000. Default: 1048576.
+func (c *ConsumerConfig) MaxPartitionFetchBytes(value int) *ConsumerConfig {
+	c.set("max.partition.fetch.bytes", value)
+	return c
+}
+
+// FetchMaxBytes sets the fetch.max.bytes configuration property.
+// Range: 0 .. 2147483135. Default: 52428800.
+func (c *ConsumerConfig) FetchMaxBytes(value int) *ConsumerConfig {
+	c.set("fetch.max.bytes", value)
+	return c
+}
+
+// FetchMinBytes sets the fetch.min.bytes configuration property.
+// Range: 1 .. 100000000. Default: 1.
+func (c *ConsumerConfig) FetchMinBytes(value int) *ConsumerConfig {
+	c.set("fetch.min.bytes", value)
+	return c
+}
+
+// FetchErrorBackoffMs sets the fetch.error.backoff.ms configuration property.
+// Range: 0 .. 300000. Default: 500.
+func (c *ConsumerConfig) FetchErrorBackoffMs(value int) *ConsumerConfig {
+	c.set("fetch.error.backoff.ms", value)
+	return c
+}
+
+// OffsetStoreMethod sets the offset.store.method configuration property.
+// Default: broker.
+//
+// Deprecated: offset.store.method is deprecated by librdkafka.
+func (c *ConsumerConfig) OffsetStoreMethod(value OffsetStoreMethod) *ConsumerConfig {
+	c.set("offset.store.method", string(value))
+	return c
+}
+
+// IsolationLevel sets the isolation.level configuration property.
+// Default: read_committed.
+func (c *ConsumerConfig) IsolationLevel(value ConsumerIsolationLevel) *ConsumerConfig {
+	c.set("isolation.level", string(value))
+	return c
+}
+
+// EnablePartitionEof sets the enable.partition.eof configuration property.
+// Default: false.
+func (c *ConsumerConfig) EnablePartitionEof(value bool) *ConsumerConfig {
+	c.set("enable.partition.eof", value)
+	return c
+}
+
+// CheckCrcs sets the check.crcs configuration property.
+// Default: false.
+func (c *ConsumerConfig) CheckCrcs(value bool) *ConsumerConfig {
+	c.set("check.crcs", value)
+	return c
+}
+
+// ClientRack sets the client.rack configuration property.
+func (c *ConsumerConfig) ClientRack(value string) *ConsumerConfig {
+	c.set("client.rack", value)
+	return c
+}
+
+// RetryBackoffMs sets the retry.backoff.ms configuration property.
+// Range: 1 .. 300000. Default: 100.
+func (c *ConsumerConfig) RetryBackoffMs(value int) *ConsumerConfig {
+	c.set("retry.backoff.ms", value)
+	return c
+}
+
+// RetryBackoffMaxMs sets the retry.backoff.max.ms configuration property.
+// Range: 1 .. 300000. Default: 1000.
+func (c *ConsumerConfig) RetryBackoffMaxMs(value int) *ConsumerConfig {
+	c.set("retry.backoff.max.ms", value)
+	return c
+}
+
+// ClientDnsLookup sets the client.dns.lookup configuration property.
+// Default: use_all_dns_ips.
+func (c *ConsumerConfig) ClientDnsLookup(value ClientDnsLookup) *ConsumerConfig {
+	c.set("client.dns.lookup", string(value))
+	return c
+}
+
+// EnableMetricsPush sets the enable.metrics.push configuration property.
+// Default: true.
+func (c *ConsumerConfig) EnableMetricsPush(value bool) *ConsumerConfig {
+	c.set("enable.metrics.push", value)
+	return c
+}
+
+// AutoCommitEnable sets the auto.commit.enable configuration property.
+// Default: true.
+//
+// Deprecated: auto.commit.enable is deprecated by librdkafka.
+func (c *ConsumerConfig) AutoCommitEnable(value bool) *ConsumerConfig {
+	c.set("auto.commit.enable", value)
+	return c
+}
+
+// AutoOffsetReset sets the auto.offset.reset configuration property.
+// Default: largest.
+func (c *ConsumerConfig) AutoOffsetReset(value AutoOffsetReset) *ConsumerConfig {
+	c.set("auto.offset.reset", string(value))
+	return c
+}
+
+// OffsetStorePath sets the offset.store.path configuration property.
+// Default: ..
+//
+// Deprecated: offset.store.path is deprecated by librdkafka.
+func (c *ConsumerConfig) OffsetStorePath(value string) *ConsumerConfig {
+	c.set("offset.store.path", value)
+	return c
+}
+
+// OffsetStoreSyncIntervalMs sets the offset.store.sync.interval.ms configuration property.
+// Range: -1 .. 86400000. Default: -1.
+//
+// Deprecated: offset.store.sync.interval.ms is deprecated by librdkafka.
+func (c *ConsumerConfig) OffsetStoreSyncIntervalMs(value int) *ConsumerConfig {
+	c.set("offset.store.sync.interval.ms", value)
+	return c
+}
+
+// ConsumeCallbackMaxMessages sets the consume.callback.max.messages configuration property.
+// Range: 0 .. 1000000. Default: 0.
+func (c *ConsumerConfig) ConsumeCallbackMaxMessages(value int) *ConsumerConfig {
+	c.set("consume.callback.max.messages", value)
+	return c
+}
+
+// BuiltinFeatures sets the builtin.features configuration property.
+func (c *AdminConfig) BuiltinFeatures(flags ...BuiltinFeaturesFlag) *AdminConfig {
+	c.set("builtin.features", joinFlags(flags))
+	return c
+}
+
+// ClientId sets the client.id configuration property.
+// Default: rdkafka.
+func (c *AdminConfig) ClientId(value string) *AdminConfig {
+	c.set("client.id", value)
+	return c
+}
+
+// ClientSoftwareName sets the client.software.name configuration property.
+// Default: librdkafka.
+func (c *AdminConfig) ClientSoftwareName(value string) *AdminConfig {
+	c.set("client.software.name", value)
+	return c
+}
+
+// ClientSoftwareVersion sets the client.software.version configuration property.
+func (c *AdminConfig) ClientSoftwareVersion(value string) *AdminConfig {
+	c.set("client.software.version", value)
+	return c
+}
+
+// MetadataBrokerList sets the metadata.broker.list configuration property.
+func (c *AdminConfig) MetadataBrokerList(value string) *AdminConfig {
+	c.set("metadata.broker.list", value)
+	return c
+}
+
+// BootstrapServers sets the bootstrap.servers configuration property, an alias for metadata.broker.list.
+func (c *AdminConfig) BootstrapServers(value string) *AdminConfig {
+	c.set("bootstrap.servers", value)
+	return c
+}
+
+// MessageMaxBytes sets the message.max.bytes configuration property.
+// Range: 1000 .. 1000000000. Default: 1000000.
+func (c *AdminConfig) MessageMaxBytes(value int) *AdminConfig {
+	c.set("message.max.bytes", value)
+	return c
+}
+
+// MessageCopyMaxBytes sets the message.copy.max.bytes configuration property.
+// Range: 0 .. 1000000000. Default: 65535.
+func (c *AdminConfig) MessageCopyMaxBytes(value int) *AdminConfig {
+	c.set("message.copy.max.bytes", value)
+	return c
+}
+
+// ReceiveMessageMaxBytes sets the receive.message.max.bytes configuration property.
+// Range: 1000 .. 2147483647. Default: 100000000.
+func (c *AdminConfig) ReceiveMessageMaxBytes(value int) *AdminConfig {
+	c.set("receive.message.max.bytes", value)
+	return c
+}
+
+// MaxInFlightRequestsPerConnection sets the max.in.flight.requests.per.connection configuration property.
+// Range: 1 .. 1000000. Default: 1000000.
+func (c *AdminConfig) MaxInFlightRequestsPerConnection(value int) *AdminConfig {
+	c.set("max.in.flight.requests.per.connection", value)
+	return c
+}
+
+// MaxInFlight sets the max.in.flight configuration property, an alias for max.in.flight.requests.per.connection.
+// Range: 1 .. 1000000. Default: 1000000.
+func (c *AdminConfig) MaxInFlight(value int) *AdminConfig {
+	c.set("max.in.flight", value)
+	return c
+}
+
+// MetadataRecoveryStrategy sets the metadata.recovery.strategy configuration property.
+// Default: rebootstrap.
+func (c *AdminConfig) MetadataRecoveryStrategy(value MetadataRecoveryStrategy) *AdminConfig {
+	c.set("metadata.recovery.strategy", string(value))
+	return c
+}
+
+// MetadataRecoveryRebootstrapTriggerMs sets the metadata.recovery.rebootstrap.trigger.ms configuration property.
+// Range: 0 .. 2147483647. Default: 300000.
+func (c *AdminConfig) MetadataRecoveryRebootstrapTriggerMs(value int) *AdminConfig {
+	c.set("metadata.recovery.rebootstrap.trigger.ms", value)
+	return c
+}
+
+// TopicMetadataRefreshIntervalMs sets the topic.metadata.refresh.interval.ms configuration property.
+// Range: -1 .. 3600000. Default: 300000.
+func (c *AdminConfig) TopicMetadataRefreshIntervalMs(value int) *AdminConfig {
+	c.set("topic.metadata.refresh.interval.ms", value)
+	return c
+}
+
+// MetadataMaxAgeMs sets the metadata.max.age.ms configuration property.
+// Range: 1 .. 86400000. Default: 900000.
+func (c *AdminConfig) MetadataMaxAgeMs(value int) *AdminConfig {
+	c.set("metadata.max.age.ms", value)
+	return c
+}
+
+// TopicMetadataRefreshFastIntervalMs sets the topic.metadata.refresh.fast.interval.ms configuration property.
+// Range: 1 .. 60000. Default: 100.
+func (c *AdminConfig) TopicMetadataRefreshFastIntervalMs(value int) *AdminConfig {
+	c.set("topic.metadata.refresh.fast.interval.ms", value)
+	return c
+}
+
+// TopicMetadataRefreshFastCnt sets the topic.metadata.refresh.fast.cnt configuration property.
+// Range: 0 .. 1000. Default: 10.
+//
+// Deprecated: topic.metadata.refresh.fast.cnt is deprecated by librdkafka.
+func (c *AdminConfig) TopicMetadataRefreshFastCnt(value int) *AdminConfig {
+	c.set("topic.metadata.refresh.fast.cnt", value)
+	return c
+}
+
+// TopicMetadataRefreshSparse sets the topic.metadata.refresh.sparse configuration property.
+// Default: true.
+func (c *AdminConfig) TopicMetadataRefreshSparse(value bool) *AdminConfig {
+	c.set("topic.metadata.refresh.sparse", value)
+	return c
+}
+
+// TopicMetadataPropagationMaxMs sets the topic.metadata.propagation.max.ms configuration property.
+// Range: 0 .. 3600000. Default: 30000.
+func (c *AdminConfig) TopicMetadataPropagationMaxMs(value int) *AdminConfig {
+	c.set("topic.metadata.propagation.max.ms", value)
+	return c
+}
+
+// TopicBlacklist sets the topic.blacklist configuration property.
+func (c *AdminConfig) TopicBlacklist(value string) *AdminConfig {
+	c.set("topic.blacklist", value)
+	return c
+}
+
+// Debug sets the debug configuration property.
+func (c *AdminConfig) Debug(flags ...DebugFlag) *AdminConfig {
+	c.set("debug", joinFlags(flags))
+	return c
+}
+
+// SocketTimeoutMs sets the socket.timeout.ms configuration property.
+// Range: 10 .. 300000. Default: 60000.
+func (c *AdminConfig) SocketTimeoutMs(value int) *AdminConfig {
+	c.set("socket.timeout.ms", value)
+	return c
+}
+
+// SocketBlockingMaxMs sets the socket.blocking.max.ms configuration property.
+// Range: 1 .. 60000. Default: 1000.
+//
+// Deprecated: socket.blocking.max.ms is deprecated by librdkafka.
+func (c *AdminConfig) SocketBlockingMaxMs(value int) *AdminConfig {
+	c.set("socket.blocking.max.ms", value)
+	return c
+}
+
+// SocketSendBufferBytes sets the socket.send.buffer.bytes configuration property.
+// Range: 0 .. 100000000. Default: 0.
+func (c *AdminConfig) SocketSendBufferBytes(value int) *AdminConfig {
+	c.set("socket.send.buffer.bytes", value)
+	return c
+}
+
+// SocketReceiveBufferBytes sets the socket.receive.buffer.bytes configuration property.
+// Range: 0 .. 100000000. Default: 0.
+func (c *AdminConfig) SocketReceiveBufferBytes(value int) *AdminConfig {
+	c.set("socket.receive.buffer.bytes", value)
+	return c
+}
+
+// SocketKeepaliveEnable sets the socket.keepalive.enable configuration property.
+// Default: false.
+func (c *AdminConfig) SocketKeepaliveEnable(value bool) *AdminConfig {
+	c.set("socket.keepalive.enable", value)
+	return c
+}
+
+// SocketNagleDisable sets the socket.nagle.disable configuration property.
+// Default: true.
+func (c *AdminConfig) SocketNagleDisable(value bool) *AdminConfig {
+	c.set("socket.nagle.disable", value)
+	return c
+}
+
+// SocketMaxFails sets the socket.max.fails configuration property.
+// Range: 0 .. 1000000. Default: 1.
+func (c *AdminConfig) SocketMaxFails(value int) *AdminConfig {
+	c.set("socket.max.fails", value)
+	return c
+}
+
+// BrokerAddressTtl sets the broker.address.ttl configuration property.
+// Range: 0 .. 86400000. Default: 1000.
+func (c *AdminConfig) BrokerAddressTtl(value int) *AdminConfig {
+	c.set("broker.address.ttl", value)
+	return c
+}
+
+// BrokerAddressFamily sets the broker.address.family configuration property.
+// Default: any.
+func (c *AdminConfig) BrokerAddressFamily(value BrokerAddressFamily) *AdminConfig {
+	c.set("broker.address.family", string(value))
+	return c
+}
+
+// SocketConnectionSetupTimeoutMs sets the socket.connection.setup.timeout.ms configuration property.
+// Range: 1000 .. 2147483647. Default: 30000.
+func (c *AdminConfig) SocketConnectionSetupTimeoutMs(value int) *AdminConfig {
+	c.set("socket.connection.setup.timeout.ms", value)
+	return c
+}
+
+// ConnectionsMaxIdleMs sets the connections.max.idle.ms configuration property.
+// Range: 0 .. 2147483647. Default: 0.
+func (c *AdminConfig) ConnectionsMaxIdleMs(value int) *AdminConfig {
+	c.set("connections.max.idle.ms", value)
+	return c
+}
+
+// ReconnectBackoffJitterMs sets the reconnect.backoff.jitter.ms configuration property.
+// Range: 0 .. 3600000. Default: 0.
+//
+// Deprecated: reconnect.backoff.jitter.ms is deprecated by librdkafka.
+func (c *AdminConfig) ReconnectBackoffJitterMs(value int) *AdminConfig {
+	c.set("reconnect.backoff.jitter.ms", value)
+	return c
+}
+
+// ReconnectBackoffMs sets the reconnect.backoff.ms configuration property.
+// Range: 0 .. 3600000. Default: 100.
+func (c *AdminConfig) ReconnectBackoffMs(value int) *AdminConfig {
+	c.set("reconnect.backoff.ms", value)
+	return c
+}
+
+// ReconnectBackoffMaxMs sets the reconnect.backoff.max.ms configuration property.
+// Range: 0 .. 3600000. Default: 10000.
+func (c *AdminConfig) ReconnectBackoffMaxMs(value int) *AdminConfig {
+	c.set("reconnect.backoff.max.ms", value)
+	return c
+}
+
+// StatisticsIntervalMs sets the statistics.interval.ms configuration property.
+// Range: 0 .. 86400000. Default: 0.
+func (c *AdminConfig) StatisticsIntervalMs(value int) *AdminConfig {
+	c.set("statistics.interval.ms", value)
+	return c
+}
+
+// EnabledEvents sets the enabled_events configuration property.
+// Range: 0 .. 2147483647. Default: 0.
+func (c *AdminConfig) EnabledEvents(value int) *AdminConfig {
+	c.set("enabled_events", value)
+	return c
+}
+
+// LogLevel sets the log_level configuration property.
+// Range: 0 .. 7. Default: 6.
+func (c *AdminConfig) LogLevel(value int) *AdminConfig {
+	c.set("log_level", value)
+	return c
+}
+
+// LogQueue sets the log.queue configuration property.
+// Default: false.
+func (c *AdminConfig) LogQueue(value bool) *AdminConfig {
+	c.set("log.queue", value)
+	return c
+}
+
+// LogThreadName sets the log.thread.name configuration property.
+// Default: true.
+func (c *AdminConfig) LogThreadName(value bool) *AdminConfig {
+	c.set("log.thread.name", value)
+	return c
+}
+
+// EnableRandomSeed sets the enable.random.seed configuration property.
+// Default: true.
+func (c *AdminConfig) EnableRandomSeed(value bool) *AdminConfig {
+	c.set("enable.random.seed", value)
+	return c
+}
+
+// LogConnectionClose sets the log.connection.close configuration property.
+// Default: true.
+func (c *AdminConfig) LogConnectionClose(value bool) *AdminConfig {
+	c.set("log.connection.close", value)
+	return c
+}
+
+// InternalTerminationSignal sets the internal.termination.signal configuration property.
+// Range: 0 .. 128. Default: 0.
+func (c *AdminConfig) InternalTerminationSignal(value int) *AdminConfig {
+	c.set("internal.termination.signal", value)
+	return c
+}
+
+// ApiVersionRequest sets the api.version.request configuration property.
+// Default: true.
+func (c *AdminConfig) ApiVersionRequest(value bool) *AdminConfig {
+	c.set("api.version.request", value)
+	return c
+}
+
+// ApiVersionRequestTimeoutMs sets the api.version.request.timeout.ms configuration property.
+// Range: 1 .. 300000. Default: 10000.
+func (c *AdminConfig) ApiVersionRequestTimeoutMs(value int) *AdminConfig {
+	c.set("api.version.request.timeout.ms", value)
+	return c
+}
+
+// ApiVersionFallbackMs sets the api.version.fallback.ms configuration property.
+// Range: 0 .. 604800000. Default: 0.
+func (c *AdminConfig) ApiVersionFallbackMs(value int) *AdminConfig {
+	c.set("api.version.fallback.ms", value)
+	return c
+}
+
+// BrokerVersionFallback sets the broker.version.fallback configuration property.
+// Default: 0.10.0.
+func (c *AdminConfig) BrokerVersionFallback(value string) *AdminConfig {
+	c.set("broker.version.fallback", value)
+	return c
+}
+
+// AllowAutoCreateTopics sets the allow.auto.create.topics configuration property.
+// Default: false.
+func (c *AdminConfig) AllowAutoCreateTopics(value bool) *AdminConfig {
+	c.set("allow.auto.create.topics", value)
+	return c
+}
+
+// SecurityProtocol sets the security.protocol configuration property.
+// Default: plaintext.
+func (c *AdminConfig) SecurityProtocol(value SecurityProtocol) *AdminConfig {
+	c.set("security.protocol", string(value))
+	return c
+}
+
+// SslCipherSuites sets the ssl.cipher.suites configuration property.
+func (c *AdminConfig) SslCipherSuites(value string) *AdminConfig {
+	c.set("ssl.cipher.suites", value)
+	return c
+}
+
+// SslCurvesList sets the ssl.curves.list configuration property.
+func (c *AdminConfig) SslCurvesList(value string) *AdminConfig {
+	c.set("ssl.curves.list", value)
+	return c
+}
+
+// SslSigalgsList sets the ssl.sigalgs.list configuration property.
+func (c *AdminConfig) SslSigalgsList(value string) *AdminConfig {
+	c.set("ssl.sigalgs.list", value)
+	return c
+}
+
+// SslKeyLocation sets the ssl.key.location configuration property.
+func (c *AdminConfig) SslKeyLocation(value string) *AdminConfig {
+	c.set("ssl.key.location", value)
+	return c
+}
+
+// SslKeyPassword sets the ssl.key.password configuration property.
+func (c *AdminConfig) SslKeyPassword(value string) *AdminConfig {
+	c.set("ssl.key.password", value)
+	return c
+}
+
+// SslKeyPem sets the ssl.key.pem configuration property.
+func (c *AdminConfig) SslKeyPem(value string) *AdminConfig {
+	c.set("ssl.key.pem", value)
+	return c
+}
+
+// SslCertificateLocation sets the ssl.certificate.location configuration property.
+func (c *AdminConfig) SslCertificateLocation(value string) *AdminConfig {
+	c.set("ssl.certificate.location", value)
+	return c
+}
+
+// SslCertificatePem sets the ssl.certificate.pem configuration property.
+func (c *AdminConfig) SslCertificatePem(value string) *AdminConfig {
+	c.set("ssl.certificate.pem", value)
+	return c
+}
+
+// SslCaLocation sets the ssl.ca.location configuration property.
+func (c *AdminConfig) SslCaLocation(value string) *AdminConfig {
+	c.set("ssl.ca.location", value)
+	return c
+}
+
+// HttpsCaLocation sets the https.ca.location configuration property.
+func (c *AdminConfig) HttpsCaLocation(value string) *AdminConfig {
+	c.set("https.ca.location", value)
+	return c
+}
+
+// HttpsCaPem sets the https.ca.pem configuration property.
+func (c *AdminConfig) HttpsCaPem(value string) *AdminConfig {
+	c.set("https.ca.pem", value)
+	return c
+}
+
+// SslCaPem sets the ssl.ca.pem configuration property.
+func (c *AdminConfig) SslCaPem(value string) *AdminConfig {
+	c.set("ssl.ca.pem", value)
+	return c
+}
+
+// SslCaCertificateStores sets the ssl.ca.certificate.stores configuration property.
+// Default: Root.
+func (c *AdminConfig) SslCaCertificateStores(value string) *AdminConfig {
+	c.set("ssl.ca.certificate.stores", value)
+	return c
+}
+
+// SslCrlLocation sets the ssl.crl.location configuration property.
+func (c *AdminConfig) SslCrlLocation(value string) *AdminConfig {
+	c.set("ssl.crl.location", value)
+	return c
+}
+
+// SslKeystoreLocation sets the ssl.keystore.location configuration property.
+func (c *AdminConfig) SslKeystoreLocation(value string) *AdminConfig {
+	c.set("ssl.keystore.location", value)
+	return c
+}
+
+// SslKeystorePassword sets the ssl.keystore.password configuration property.
+func (c *AdminConfig) SslKeystorePassword(value string) *AdminConfig {
+	c.set("ssl.keystore.password", value)
+	return c
+}
+
+// SslProviders sets the ssl.providers configuration property.
+func (c *AdminConfig) SslProviders(value string) *AdminConfig {
+	c.set("ssl.providers", value)
+	return c
+}
+
+// SslEngineLocation sets the ssl.engine.location configuration property.
+//
+// Deprecated: ssl.engine.location is deprecated by librdkafka.
+func (c *AdminConfig) SslEngineLocation(value string) *AdminConfig {
+	c.set("ssl.engine.location", value)
+	return c
+}
+
+// SslEngineId sets the ssl.engine.id configuration property.
+// Default: dynamic.
+func (c *AdminConfig) SslEngineId(value string) *AdminConfig {
+	c.set("ssl.engine.id", value)
+	return c
+}
+
+// EnableSslCertificateVerification sets the enable.ssl.certificate.verification configuration property.
+// Default: true.
+func (c *AdminConfig) EnableSslCertificateVerification(value bool) *AdminConfig {
+	c.set("enable.ssl.certificate.verification", value)
+	return c
+}
+
+// SslEndpointIdentificationAlgorithm sets the ssl.endpoint.identification.algorithm configuration property.
+// Default: https.
+func (c *AdminConfig) SslEndpointIdentificationAlgorithm(value SslEndpointIdentificationAlgorithm) *AdminConfig {
+	c.set("ssl.endpoint.identification.algorithm", string(value))
+	return c
+}
+
+// SaslMechanisms sets the sasl.mechanisms configuration property.
+// Default: GSSAPI.
+func (c *AdminConfig) SaslMechanisms(value string) *AdminConfig {
+	c.set("sasl.mechanisms", value)
+	return c
+}
+
+// SaslMechanism sets the sasl.mechanism configuration property, an alias for sasl.mechanisms.
+// Default: GSSAPI.
+func (c *AdminConfig) SaslMechanism(value string) *AdminConfig {
+	c.set("sasl.mechanism", value)
+	return c
+}
+
+// SaslKerberosServiceName sets the sasl.kerberos.service.name configuration property.
+// Default: kafka.
+func (c *AdminConfig) SaslKerberosServiceName(value string) *AdminConfig {
+	c.set("sasl.kerberos.service.name", value)
+	return c
+}
+
+// SaslKerberosPrincipal sets the sasl.kerberos.principal configuration property.
+// Default: kafkaclient.
+func (c *AdminConfig) SaslKerberosPrincipal(value string) *AdminConfig {
+	c.set("sasl.kerberos.principal", value)
+	return c
+}
+
+// SaslKerberosKinitCmd sets the sasl.kerberos.kinit.cmd configuration property.
+func (c *AdminConfig) SaslKerberosKinitCmd(value string) *AdminConfig {
+	c.set("sasl.kerberos.kinit.cmd", value)
+	return c
+}
+
+// SaslKerberosKeytab sets the sasl.kerberos.keytab configuration property.
+func (c *AdminConfig) SaslKerberosKeytab(value string) *AdminConfig {
+	c.set("sasl.kerberos.keytab", value)
+	return c
+}
+
+// SaslKerberosMinTimeBeforeRelogin sets the sasl.kerberos.min.time.before.relogin configuration property.
+// Range: 0 .. 86400000. Default: 60000.
+func (c *AdminConfig) SaslKerberosMinTimeBeforeRelogin(value int) *AdminConfig {
+	c.set("sasl.kerberos.min.time.before.relogin", value)
+	return c
+}
+
+// SaslUsername sets the sasl.username configuration property.
+func (c *AdminConfig) SaslUsername(value string) *AdminConfig {
+	c.set("sasl.username", value)
+	return c
+}
+
+// SaslPassword sets the sasl.password configuration property.
+func (c *AdminConfig) SaslPassword(value string) *AdminConfig {
+	c.set("sasl.password", value)
+	return c
+}
+
+// SaslOauthbearerConfig sets the sasl.oauthbearer.config configuration property.
+func (c *AdminConfig) SaslOauthbearerConfig(value string) *AdminConfig {
+	c.set("sasl.oauthbearer.config", value)
+	return c
+}
+
+// EnableSaslOauthbearerUnsecureJwt sets the enable.sasl.oauthbearer.unsecure.jwt configuration property.
+// Default: false.
+func (c *AdminConfig) EnableSaslOauthbearerUnsecureJwt(value bool) *AdminConfig {
+	c.set("enable.sasl.oauthbearer.unsecure.jwt", value)
+	return c
+}
+
+// SaslOauthbearerMethod sets the sasl.oauthbearer.method configuration property.
+// Default: default.
+func (c *AdminConfig) SaslOauthbearerMethod(value SaslOauthbearerMethod) *AdminConfig {
+	c.set("sasl.oauthbearer.method", string(value))
+	return c
+}
+
+// SaslOauthbearerClientId sets the sasl.oauthbearer.client.id configuration property.
+func (c *AdminConfig) SaslOauthbearerClientId(value string) *AdminConfig {
+	c.set("sasl.oauthbearer.client.id", value)
+	return c
+}
+
+// SaslOauthbearerClientSecret sets the sasl.oauthbearer.client.secret configuration property.
+func (c *AdminConfig) SaslOauthbearerClientSecret(value string) *AdminConfig {
+	c.set("sasl.oauthbearer.client.secret", value)
+	return c
+}
+
+// SaslOauthbearerScope sets the sasl.oauthbearer.scope configuration property.
+func (c *AdminConfig) SaslOauthbearerScope(value string) *AdminConfig {
+	c.set("sasl.oauthbearer.scope", value)
+	return c
+}
+
+// SaslOauthbearerExtensions sets the sasl.oauthbearer.extensions configuration property.
+func (c *AdminConfig) SaslOauthbearerExtensions(value string) *AdminConfig {
+	c.set("sasl.oauthbearer.extensions", value)
+	return c
+}
+
+// SaslOauthbearerTokenEndpointUrl sets the sasl.oauthbearer.token.endpoint.url configuration property.
+func (c *AdminConfig) SaslOauthbearerTokenEndpointUrl(value string) *AdminConfig {
+	c.set("sasl.oauthbearer.token.endpoint.url", value)
+	return c
+}
+
+// PluginLibraryPaths sets the plugin.library.paths configuration property.
+func (c *AdminConfig) PluginLibraryPaths(value string) *AdminConfig {
+	c.set("plugin.library.paths", value)
+	return c
+}
+
+// ClientRack sets the client.rack configuration property.
+func (c *AdminConfig) ClientRack(value string) *AdminConfig {
+	c.set("client.rack", value)
+	return c
+}
+
+// RetryBackoffMs sets the retry.backoff.ms configuration property.
+// Range: 1 .. 300000. Default: 100.
+func (c *AdminConfig) RetryBackoffMs(value int) *AdminConfig {
+	c.set("retry.backoff.ms", value)
+	return c
+}
+
+// RetryBackoffMaxMs sets the retry.backoff.max.ms configuration property.
+// Range: 1 .. 300000. Default: 1000.
+func (c *AdminConfig) RetryBackoffMaxMs(value int) *AdminConfig {
+	c.set("retry.backoff.max.ms", value)
+	return c
+}
+
+// ClientDnsLookup sets the client.dns.lookup configuration property.
+// Default: use_all_dns_ips.
+func (c *AdminConfig) ClientDnsLookup(value ClientDnsLookup) *AdminConfig {
+	c.set("client.dns.lookup", string(value))
+	return c
+}
+
+// EnableMetricsPush sets the enable.metrics.push configuration property.
+// Default: true.
+func (c *AdminConfig) EnableMetricsPush(value bool) *AdminConfig {
+	c.set("enable.metrics.push", value)
+	return c
+}
@@ -0,0 +1,399 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// confluent-kafka-go internal tool to generate the typed configuration
+// builders from the librdkafka configuration properties.
+//
+// Usage:
+//
+//	go_rdkafka_genconf [-properties CONFIGURATION.md] <outfile>
+//
+// The properties are read from the linked librdkafka, or from the
+// CONFIGURATION.md file of a librdkafka release.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// property is a row of the configuration properties table.
+type property struct {
+	name        string
+	scope       string // "*", "P" or "C"
+	typ         string // *Type:* of the description
+	rangeStr    string
+	defaultStr  string
+	alias       string
+	deprecated  bool
+	description string
+}
+
+var (
+	typeRegexp  = regexp.MustCompile(`<br>\*Type: ([^*]+)\*\s*$`)
+	aliasRegexp = regexp.MustCompile("Alias for `([^`]+)`")
+)
+
+// enumTypeNames are the Go type names of the enum properties which would
+// otherwise conflict with existing types.
+var enumTypeNames = map[string]string{
+	"isolation.level": "ConsumerIsolationLevel",
+}
+
+// builders are the generated configuration builders and the property
+// scopes they accept.
+var builders = []struct {
+	name   string
+	scopes string
+}{
+	{"ProducerConfig", "*P"},
+	{"ConsumerConfig", "*C"},
+	{"AdminConfig", "*"},
+}
+
+// camelCase transforms a property name or value to CamelCase.
+func camelCase(s string) string {
+	ret := ""
+	for _, v := range regexp.MustCompile(`[^A-Za-z0-9]+`).Split(s, -1) {
+		if len(v) == 0 {
+			continue
+		}
+		ret += strings.ToUpper(v[:1]) + strings.ToLower(v[1:])
+	}
+	return ret
+}
+
+// splitList splits a comma separated list of the range column.
+func splitList(s string) []string {
+	var values []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// parseProperties parses the configuration properties table, keeping the
+// first definition of properties defined both globally and for topics.
+func parseProperties(r io.Reader) ([]*property, error) {
+	var props []*property
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "Property ") || strings.HasPrefix(line, "---") {
+			continue
+		}
+
+		fields := strings.Split(line, "|")
+		if len(fields) < 6 {
+			continue
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		p := &property{
+			name:        fields[0],
+			scope:       fields[1],
+			rangeStr:    fields[2],
+			defaultStr:  fields[3],
+			description: strings.Join(fields[5:], "|"),
+		}
+
+		m := typeRegexp.FindStringSubmatch(p.description)
+		if m == nil {
+			return nil, fmt.Errorf("%s: no type found", p.name)
+		}
+		p.typ = m[1]
+
+		if m = aliasRegexp.FindStringSubmatch(p.description); m != nil {
+			p.alias = m[1]
+		}
+		p.deprecated = strings.Contains(p.description, "**DEPRECATED**")
+
+		if seen[p.name] {
+			continue
+		}
+		seen[p.name] = true
+		props = append(props, p)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(props) == 0 {
+		return nil, fmt.Errorf("no configuration properties found")
+	}
+
+	return props, nil
+}
+
+// generator writes the generated code.
+type generator struct {
+	bytes.Buffer
+	props map[string]*property
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.Buffer, format, args...)
+}
+
+// target returns the property p is an alias for, or p.
+func (g *generator) target(p *property) *property {
+	if t, found := g.props[p.alias]; found {
+		return t
+	}
+	return p
+}
+
+// configType returns the configType constant of p.
+func configType(p *property) string {
+	switch p.typ {
+	case "integer":
+		return "configTypeInt"
+	case "boolean":
+		return "configTypeBool"
+	case "float":
+		return "configTypeFloat"
+	case "enum value":
+		return "configTypeEnum"
+	case "CSV flags":
+		return "configTypeFlags"
+	case "see dedicated API", "pointer":
+		return "configTypePointer"
+	}
+	return "configTypeString"
+}
+
+// enumType returns the Go type of the values of the enum or flags
+// property p.
+func (g *generator) enumType(p *property) string {
+	p = g.target(p)
+	if name, found := enumTypeNames[p.name]; found {
+		return name
+	}
+	if p.typ == "CSV flags" {
+		return camelCase(p.name) + "Flag"
+	}
+	return camelCase(p.name)
+}
+
+// values returns the values of the enum or flags property p.
+func values(p *property) []string {
+	if p.typ == "CSV flags" && p.rangeStr == "" {
+		return splitList(p.defaultStr)
+	}
+	return splitList(p.rangeStr)
+}
+
+func (g *generator) writeEnumTypes(props []*property) {
+	for _, p := range props {
+		if p.alias != "" || (p.typ != "enum value" && p.typ != "CSV flags") {
+			continue
+		}
+
+		typeName := g.enumType(p)
+		kind := "value"
+		if p.typ == "CSV flags" {
+			kind = "flag"
+		}
+
+		g.printf("// %s is a %s of the %s configuration property.\n", typeName, kind, p.name)
+		g.printf("type %s string\n\n", typeName)
+		g.printf("// %s values\n", typeName)
+		g.printf("const (\n")
+		for _, v := range values(p) {
+			g.printf("\t%s%s %s = %q\n", typeName, camelCase(v), typeName, v)
+		}
+		g.printf(")\n\n")
+	}
+}
+
+func (g *generator) writeProperties(props []*property) {
+	g.printf("// configProperties are the librdkafka configuration properties, by name.\n")
+	g.printf("var configProperties = map[string]*configProperty{\n")
+	for _, p := range props {
+		scope := map[string]string{
+			"*": "configScopeAll",
+			"P": "configScopeProducer",
+			"C": "configScopeConsumer",
+		}[p.scope]
+
+		g.printf("\t%q: {name: %q, scope: %s, typ: %s", p.name, p.name, scope, configType(p))
+
+		switch p.typ {
+		case "integer", "float":
+			var min, max string
+			if _, err := fmt.Sscanf(p.rangeStr, "%s .. %s", &min, &max); err == nil {
+				g.printf(", min: %s, max: %s", min, max)
+			}
+		case "enum value", "CSV flags":
+			g.printf(", values: %#v", values(p))
+		}
+
+		if p.alias != "" {
+			g.printf(", alias: %q", p.alias)
+		}
+		if p.deprecated {
+			g.printf(", deprecated: true")
+		}
+		g.printf("},\n")
+	}
+	g.printf("}\n\n")
+}
+
+func (g *generator) writeSetters(props []*property, builder string, scopes string) {
+	for _, p := range props {
+		if !strings.Contains(scopes, p.scope) || configType(p) == "configTypePointer" {
+			continue
+		}
+
+		method := camelCase(p.name)
+
+		g.printf("// %s sets the %s configuration property", method, p.name)
+		if p.alias != "" {
+			g.printf(", an alias for %s", p.alias)
+		}
+		g.printf(".\n")
+		switch p.typ {
+		case "integer", "float":
+			if p.rangeStr != "" {
+				g.printf("// Range: %s.", p.rangeStr)
+				if p.defaultStr != "" {
+					g.printf(" Default: %s.", p.defaultStr)
+				}
+				g.printf("\n")
+			}
+		case "CSV flags":
+		default:
+			if p.defaultStr != "" {
+				g.printf("// Default: %s.\n", p.defaultStr)
+			}
+		}
+		if p.deprecated {
+			g.printf("//\n// Deprecated: %s is deprecated by librdkafka.\n", p.name)
+		}
+
+		switch configType(g.target(p)) {
+		case "configTypeInt":
+			g.printf("func (c *%s) %s(value int) *%s {\n", builder, method, builder)
+			g.printf("\tc.set(%q, value)\n", p.name)
+		case "configTypeBool":
+			g.printf("func (c *%s) %s(value bool) *%s {\n", builder, method, builder)
+			g.printf("\tc.set(%q, value)\n", p.name)
+		case "configTypeFloat":
+			g.printf("func (c *%s) %s(value float64) *%s {\n", builder, method, builder)
+			g.printf("\tc.set(%q, value)\n", p.name)
+		case "configTypeEnum":
+			g.printf("func (c *%s) %s(value %s) *%s {\n", builder, method, g.enumType(p), builder)
+			g.printf("\tc.set(%q, string(value))\n", p.name)
+		case "configTypeFlags":
+			g.printf("func (c *%s) %s(flags ...%s) *%s {\n", builder, method, g.enumType(p), builder)
+			g.printf("\tc.set(%q, joinFlags(flags))\n", p.name)
+		default:
+			g.printf("func (c *%s) %s(value string) *%s {\n", builder, method, builder)
+			g.printf("\tc.set(%q, value)\n", p.name)
+		}
+		g.printf("\treturn c\n}\n\n")
+	}
+}
+
+// generate returns the generated code of props.
+func generate(props []*property, source string) ([]byte, error) {
+	g := &generator{props: make(map[string]*property, len(props))}
+	for _, p := range props {
+		g.props[p.name] = p
+	}
+
+	now := time.Now()
+	g.printf("package kafka\n\n")
+	g.printf("// Copyright 2016-%d Confluent Inc.\n", now.Year())
+	g.printf("// AUTOMATICALLY GENERATED ON %v USING %s\n\n", now, source)
+
+	g.writeEnumTypes(props)
+	g.writeProperties(props)
+	for _, b := range builders {
+		g.writeSetters(props, b.name, b.scopes)
+	}
+
+	return format.Source(g.Bytes())
+}
+
+func main() {
+	propertiesFile := flag.String("properties", "",
+		"CONFIGURATION.md file to read the properties from, instead of the linked librdkafka")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-properties CONFIGURATION.md] <outfile>\n", os.Args[0])
+		os.Exit(1)
+	}
+	outfile := flag.Arg(0)
+
+	var source string
+	var in *os.File
+	var err error
+	if *propertiesFile != "" {
+		source = *propertiesFile
+		in, err = os.Open(*propertiesFile)
+		if err != nil {
+			panic(err)
+		}
+	} else {
+		_, version := kafka.LibraryVersion()
+		source = "librdkafka " + version
+		in, err = os.CreateTemp("", "go_rdkafka_genconf")
+		if err != nil {
+			panic(err)
+		}
+		defer os.Remove(in.Name())
+
+		if err = kafka.WriteConfigProperties(in); err != nil {
+			panic(err)
+		}
+		if _, err = in.Seek(0, io.SeekStart); err != nil {
+			panic(err)
+		}
+	}
+	defer in.Close()
+
+	props, err := parseProperties(in)
+	if err != nil {
+		panic(err)
+	}
+
+	code, err := generate(props, source)
+	if err != nil {
+		panic(err)
+	}
+
+	if err = os.WriteFile(outfile, code, 0644); err != nil {
+		panic(err)
+	}
+}
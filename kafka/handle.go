@@ -17,7 +17,9 @@
 package kafka
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
 	"strings"
 	"sync"
 	"time"
@@ -27,6 +29,17 @@ import (
 /*
 #include "select_rdkafka.h"
 #include <stdlib.h>
+
+// conf_get_int returns the integer configuration property name of rk,
+// or defval if it can't be read.
+static int conf_get_int (rd_kafka_t *rk, const char *name, int defval) {
+  char buf[32];
+  size_t size = sizeof(buf);
+  if (rd_kafka_conf_get(rd_kafka_conf(rk), name, buf, &size) !=
+      RD_KAFKA_CONF_OK)
+    return defval;
+  return atoi(buf);
+}
 */
 import "C"
 
@@ -95,6 +108,9 @@ type handle struct {
 	logq          *C.rd_kafka_queue_t
 	closeLogsChan bool
 
+	// SASL queue, served for the go.oauthbearer.token.provider
+	saslq *C.rd_kafka_queue_t
+
 	// Topic <-> rkt caches
 	rktCacheLock sync.Mutex
 	// topic name -> rkt cache
@@ -105,6 +121,16 @@ type handle struct {
 	// Cached instance name to avoid CGo call in String()
 	name string
 
+	// Application callback for Stats events (go.stats.cb)
+	statsCb StatsCb
+
+	// cgoids of the handle's entries in the global cgo map
+	globalCgoids []uintptr
+	// topic name -> cgoid of the topic's Go partitioner
+	topicPartitioners map[string]uintptr
+	// Interceptor chains (go.producer.interceptors, go.consumer.interceptors)
+	interceptors *interceptors
+
 	//
 	// cgo map
 	// Maps C callbacks based on cgoid back to its Go object
@@ -147,13 +173,17 @@ func (h *handle) setup() {
 }
 
 func (h *handle) cleanup() {
-	if h.logs != nil {
+	if h.logq != nil {
 		C.rd_kafka_queue_destroy(h.logq)
 		if h.closeLogsChan {
 			close(h.logs)
 		}
 	}
 
+	if h.saslq != nil {
+		C.rd_kafka_queue_destroy(h.saslq)
+	}
+
 	for _, crkt := range h.rktCache {
 		C.rd_kafka_topic_destroy(crkt)
 	}
@@ -163,13 +193,15 @@ func (h *handle) cleanup() {
 	}
 }
 
-func (h *handle) setupLogQueue(logsChan chan LogEvent, termChan chan bool) {
-	if logsChan == nil {
-		logsChan = make(chan LogEvent, 10000)
-		h.closeLogsChan = true
-	}
+func (h *handle) setupLogQueue(logsChan chan LogEvent, logger *slog.Logger, termChan chan bool) {
+	if logger == nil {
+		if logsChan == nil {
+			logsChan = make(chan LogEvent, 10000)
+			h.closeLogsChan = true
+		}
 
-	h.logs = logsChan
+		h.logs = logsChan
+	}
 
 	// Let librdkafka forward logs to our log queue instead of the main queue
 	h.logq = C.rd_kafka_queue_new(h.rk)
@@ -178,12 +210,79 @@ func (h *handle) setupLogQueue(logsChan chan LogEvent, termChan chan bool) {
 	// Start a polling goroutine to consume the log queue
 	h.waitGroup.Add(1)
 	go func() {
-		h.pollLogEvents(h.logs, 100, termChan)
+		h.pollLogEvents(h.logs, logger, 100, termChan)
 		h.waitGroup.Done()
 	}()
 
 }
 
+// yieldOnDone arranges for any blocking rd_kafka_queue_poll() on rkqu to
+// return immediately once ctx is done.
+// The returned stop function must be called, before rkqu is destroyed,
+// when the caller is no longer polling the queue.
+func (h *handle) yieldOnDone(ctx context.Context, rkqu *C.rd_kafka_queue_t) (stop func()) {
+	yielded := make(chan struct{})
+	stopAfterFunc := context.AfterFunc(ctx, func() {
+		C.rd_kafka_queue_yield(rkqu)
+		close(yielded)
+	})
+
+	return func() {
+		if !stopAfterFunc() {
+			// The yield is already in progress, wait for it to
+			// finish so that rkqu is not used after the caller returns.
+			<-yielded
+		}
+	}
+}
+
+// confGetInt returns the integer configuration property name of the
+// client instance, or defval if it can't be read.
+func (h *handle) confGetInt(name string, defval int) int {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	return int(C.conf_get_int(h.rk, cName, C.int(defval)))
+}
+
+// callContext runs call, a blocking librdkafka call bounded by
+// cTimeoutMs, on a separate go-routine and waits for it to return or for
+// ctx to be done, whichever happens first.
+// cTimeoutMs is the remaining time of ctx or, if ctx has no deadline, the
+// socket.timeout.ms of the client: librdkafka can't cancel the call, which
+// must thus not block indefinitely once ctx is cancelled.
+// If ctx is done first ctx.Err() is returned immediately while call keeps
+// running in the background until it returns; call must thus free any
+// C resources it allocates and must not hand over results to the caller
+// other than through its return value or variables that are only read
+// after a nil error.
+// The go-routine is tracked by the handle's waitGroup so that Close()
+// does not destroy the client instance while call is still running.
+func (h *handle) callContext(ctx context.Context, call func(cTimeoutMs C.int) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	cTimeoutMs := cTimeoutFromContext(ctx)
+	if cTimeoutMs == cTimeoutInfinite {
+		cTimeoutMs = C.int(h.confGetInt("socket.timeout.ms", 60000))
+	}
+
+	errChan := make(chan error, 1)
+
+	h.waitGroup.Add(1)
+	go func() {
+		defer h.waitGroup.Done()
+		errChan <- call(cTimeoutMs)
+	}()
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // getRkt0 finds or creates and returns a C topic_t object from the local cache.
 func (h *handle) getRkt0(topic string, ctopic *C.char, doLock bool) (crkt *C.rd_kafka_topic_t) {
 	if doLock {
@@ -200,7 +299,7 @@ func (h *handle) getRkt0(topic string, ctopic *C.char, doLock bool) (crkt *C.rd_
 		defer C.free(unsafe.Pointer(ctopic))
 	}
 
-	crkt = C.rd_kafka_topic_new(h.rk, ctopic, nil)
+	crkt = C.rd_kafka_topic_new(h.rk, ctopic, h.newTopicConf(topic))
 	if crkt == nil {
 		panic(fmt.Sprintf("Unable to create new C topic \"%s\": %s",
 			topic, C.GoString(C.rd_kafka_err2str(C.rd_kafka_last_error()))))
@@ -286,6 +385,55 @@ func (h *handle) cgoGet(cgoid int) (cg cgoif, found bool) {
 	return cg, found
 }
 
+// globalCgo is the cgo map of the Go objects referenced by the C callbacks
+// that are not passed the handle, but only an opaque, such as the
+// partitioner callback.
+var globalCgo = struct {
+	lock      sync.RWMutex
+	cgoidNext uintptr
+	cgomap    map[uintptr]cgoif
+}{cgomap: make(map[uintptr]cgoif)}
+
+// globalCgoPut adds object cg to the global cgo map and returns a unique
+// id for the added entry.
+// The entry is deleted by globalCgoRelease().
+// Thread-safe.
+func (h *handle) globalCgoPut(cg cgoif) (cgoid uintptr) {
+	globalCgo.lock.Lock()
+	defer globalCgo.lock.Unlock()
+
+	globalCgo.cgoidNext++
+	if globalCgo.cgoidNext == 0 {
+		globalCgo.cgoidNext++
+	}
+	cgoid = globalCgo.cgoidNext
+	globalCgo.cgomap[cgoid] = cg
+	h.globalCgoids = append(h.globalCgoids, cgoid)
+	return cgoid
+}
+
+// globalCgoGet looks up cgoid in the global cgo map and returns the object,
+// if found, without deleting it. Else returns nil, false.
+// Thread-safe.
+func globalCgoGet(cgoid uintptr) (cg cgoif, found bool) {
+	globalCgo.lock.RLock()
+	defer globalCgo.lock.RUnlock()
+	cg, found = globalCgo.cgomap[cgoid]
+	return cg, found
+}
+
+// globalCgoRelease deletes the handle's entries from the global cgo map.
+// Must be called once the client instance is destroyed, as the C
+// callbacks may be called until then.
+func (h *handle) globalCgoRelease() {
+	globalCgo.lock.Lock()
+	defer globalCgo.lock.Unlock()
+	for _, cgoid := range h.globalCgoids {
+		delete(globalCgo.cgomap, cgoid)
+	}
+	h.globalCgoids = nil
+}
+
 // setOauthBearerToken - see rd_kafka_oauthbearer_set_token()
 func (h *handle) setOAuthBearerToken(oauthBearerToken OAuthBearerToken) error {
 	cTokenValue := C.CString(oauthBearerToken.TokenValue)
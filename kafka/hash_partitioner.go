@@ -0,0 +1,275 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kafka
+
+import (
+	"fmt"
+	"hash/crc32"
+	"math/rand"
+	"unsafe"
+)
+
+/*
+#include <stdlib.h>
+#include <string.h>
+#include "select_rdkafka.h"
+
+// librdkafka partitioner of a Partitioner, by name.
+// The random partitioners may only be called for keys that are
+// partitioned consistently, as rkt is NULL.
+static int32_t c_partition (const char *name, const void *key, size_t keylen,
+                            int key_is_null, int32_t partition_cnt) {
+  if (key_is_null)
+    key = NULL;
+  else if (!key)
+    key = "";
+
+  if (!strcmp(name, "consistent"))
+    return rd_kafka_msg_partitioner_consistent(NULL, key, keylen,
+                                               partition_cnt, NULL, NULL);
+  if (!strcmp(name, "consistent_random"))
+    return rd_kafka_msg_partitioner_consistent_random(NULL, key, keylen,
+                                                      partition_cnt,
+                                                      NULL, NULL);
+  if (!strcmp(name, "murmur2"))
+    return rd_kafka_msg_partitioner_murmur2(NULL, key, keylen,
+                                            partition_cnt, NULL, NULL);
+  if (!strcmp(name, "murmur2_random"))
+    return rd_kafka_msg_partitioner_murmur2_random(NULL, key, keylen,
+                                                   partition_cnt, NULL, NULL);
+  if (!strcmp(name, "fnv1a"))
+    return rd_kafka_msg_partitioner_fnv1a(NULL, key, keylen,
+                                          partition_cnt, NULL, NULL);
+  if (!strcmp(name, "fnv1a_random"))
+    return rd_kafka_msg_partitioner_fnv1a_random(NULL, key, keylen,
+                                                 partition_cnt, NULL, NULL);
+  return RD_KAFKA_PARTITION_UA;
+}
+*/
+import "C"
+
+// Partitioner returns the partition of a message key, for a topic with
+// partitionCount partitions.
+//
+// A nil key is a null key.
+// available reports whether a partition has a leader broker, and may be
+// nil if all the partitions are considered available.
+// PartitionAny is returned if partitionCount is not positive, as
+// librdkafka does when no partition is available.
+//
+// The Partitioner functions of this package are compatible with the
+// librdkafka partitioners of the same name, see the `partitioner`
+// configuration property: they return the same partitions for the same
+// keys. Murmur2Partitioner() is also compatible with the Java client's
+// default partitioner for keyed messages.
+// A Partitioner can be used to know the partition of a key before
+// producing it, or as a Go partitioner, see Partitioner.PartitionerFunc().
+type Partitioner func(key []byte, partitionCount int32, available func(partition int32) bool) int32
+
+// PartitionerFunc returns a PartitionerFunc, for the go.partitioner and
+// go.topic.partitioners configuration properties, partitioning with p.
+func (p Partitioner) PartitionerFunc() PartitionerFunc {
+	return func(topic string, key []byte, partitionCount int32, available func(partition int32) bool) int32 {
+		return p(key, partitionCount, available)
+	}
+}
+
+// partitionerNames maps the names of the partitioner configuration
+// property to their Partitioner.
+var partitionerNames = map[string]Partitioner{
+	"random":            RandomPartitioner,
+	"consistent":        ConsistentPartitioner,
+	"consistent_random": ConsistentRandomPartitioner,
+	"murmur2":           Murmur2Partitioner,
+	"murmur2_random":    Murmur2RandomPartitioner,
+	"fnv1a":             FNV1aPartitioner,
+	"fnv1a_random":      FNV1aRandomPartitioner,
+}
+
+// NewPartitioner returns the Partitioner of the librdkafka partitioner
+// name, as set in the `partitioner` configuration property.
+//
+// Returns ErrInvalidArg if name is not a librdkafka partitioner.
+func NewPartitioner(name string) (Partitioner, error) {
+	p, found := partitionerNames[name]
+	if !found {
+		return nil, newErrorFromString(ErrInvalidArg,
+			fmt.Sprintf("Unknown partitioner %s", name))
+	}
+	return p, nil
+}
+
+// RandomPartitioner returns a random partition, trying not to return an
+// unavailable partition, as the librdkafka random partitioner.
+func RandomPartitioner(key []byte, partitionCount int32, available func(partition int32) bool) int32 {
+	if partitionCount <= 0 {
+		return PartitionAny
+	}
+	partition := rand.Int31n(partitionCount)
+	if available != nil && !available(partition) {
+		return rand.Int31n(partitionCount)
+	}
+	return partition
+}
+
+// ConsistentPartitioner returns the partition of the CRC32 hash of the key,
+// as the librdkafka consistent partitioner.
+// Null and empty keys are partitioned as an empty key.
+func ConsistentPartitioner(key []byte, partitionCount int32, available func(partition int32) bool) int32 {
+	if partitionCount <= 0 {
+		return PartitionAny
+	}
+	return int32(crc32.ChecksumIEEE(key) % uint32(partitionCount))
+}
+
+// ConsistentRandomPartitioner returns the partition of the CRC32 hash of
+// the key, or a random partition for null and empty keys, as the
+// librdkafka consistent_random partitioner, which is librdkafka's default
+// partitioner.
+func ConsistentRandomPartitioner(key []byte, partitionCount int32, available func(partition int32) bool) int32 {
+	if len(key) == 0 {
+		return RandomPartitioner(key, partitionCount, available)
+	}
+	return ConsistentPartitioner(key, partitionCount, available)
+}
+
+// Murmur2Partitioner returns the partition of the Java compatible murmur2
+// hash of the key, as the librdkafka murmur2 partitioner and the Java
+// client's default partitioner.
+// Null keys are partitioned as an empty key.
+func Murmur2Partitioner(key []byte, partitionCount int32, available func(partition int32) bool) int32 {
+	if partitionCount <= 0 {
+		return PartitionAny
+	}
+	return int32((murmur2(key) & 0x7fffffff) % uint32(partitionCount))
+}
+
+// Murmur2RandomPartitioner returns the partition of the Java compatible
+// murmur2 hash of the key, or a random partition for null keys, as the
+// librdkafka murmur2_random partitioner.
+func Murmur2RandomPartitioner(key []byte, partitionCount int32, available func(partition int32) bool) int32 {
+	if key == nil {
+		return RandomPartitioner(key, partitionCount, available)
+	}
+	return Murmur2Partitioner(key, partitionCount, available)
+}
+
+// FNV1aPartitioner returns the partition of the FNV-1a hash of the key, as
+// the librdkafka fnv1a partitioner.
+// Null keys are partitioned as an empty key.
+func FNV1aPartitioner(key []byte, partitionCount int32, available func(partition int32) bool) int32 {
+	if partitionCount <= 0 {
+		return PartitionAny
+	}
+	return int32(fnv1a(key) % uint32(partitionCount))
+}
+
+// FNV1aRandomPartitioner returns the partition of the FNV-1a hash of the
+// key, or a random partition for null keys, as the librdkafka fnv1a_random
+// partitioner.
+func FNV1aRandomPartitioner(key []byte, partitionCount int32, available func(partition int32) bool) int32 {
+	if key == nil {
+		return RandomPartitioner(key, partitionCount, available)
+	}
+	return FNV1aPartitioner(key, partitionCount, available)
+}
+
+// murmur2 returns the murmur2 hash of data, as the Java client's
+// Utils.murmur2() and librdkafka's rd_murmur2().
+func murmur2(data []byte) uint32 {
+	const (
+		seed = uint32(0x9747b28c)
+		m    = uint32(0x5bd1e995)
+		r    = 24
+	)
+
+	length := len(data)
+	h := seed ^ uint32(length)
+
+	for i := 0; i+4 <= length; i += 4 {
+		k := uint32(data[i]) | uint32(data[i+1])<<8 |
+			uint32(data[i+2])<<16 | uint32(data[i+3])<<24
+		k *= m
+		k ^= k >> r
+		k *= m
+		h *= m
+		h ^= k
+	}
+
+	tail := data[length&^3:]
+	switch len(tail) {
+	case 3:
+		h ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		h ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint32(tail[0])
+		h *= m
+	}
+
+	h ^= h >> 13
+	h *= m
+	h ^= h >> 15
+
+	return h
+}
+
+// fnv1a returns the absolute value of the 32-bit FNV-1a hash of data, as
+// librdkafka's rd_fnv1a(), which is compatible with Sarama's hash
+// partitioner.
+func fnv1a(data []byte) uint32 {
+	const (
+		offset = uint32(0x811c9dc5)
+		prime  = uint32(0x01000193)
+	)
+
+	h := offset
+	for _, b := range data {
+		h ^= uint32(b)
+		h *= prime
+	}
+
+	if int32(h) < 0 {
+		h = uint32(-int32(h))
+	}
+	return h
+}
+
+// cPartition returns the partition of key with the librdkafka partitioner
+// name, used for testing the compatibility of the Partitioner functions.
+// The random partitioners may only be used for keys that are partitioned
+// consistently.
+func cPartition(name string, key []byte, partitionCount int32) int32 {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	var cKey unsafe.Pointer
+	if len(key) > 0 {
+		cKey = C.CBytes(key)
+		defer C.free(cKey)
+	}
+
+	keyIsNull := C.int(0)
+	if key == nil {
+		keyIsNull = 1
+	}
+
+	return int32(C.c_partition(cName, cKey, C.size_t(len(key)), keyIsNull,
+		C.int32_t(partitionCount)))
+}
@@ -0,0 +1,122 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kafka
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestMurmur2 tests murmur2 with the test vectors of the Java client.
+func TestMurmur2(t *testing.T) {
+	for key, expected := range map[string]int32{
+		"21":                         -973932308,
+		"foobar":                     -790332482,
+		"a-little-bit-long-string":   -985981536,
+		"a-little-bit-longer-string": -1486304829,
+		"lkjh234lh9fiuh90y23oiuhsafujhadof229phr9h19h89h8": -58897971,
+		"abc": 479470107,
+	} {
+		if h := int32(murmur2([]byte(key))); h != expected {
+			t.Errorf("murmur2(%q): expected %d, got %d", key, expected, h)
+		}
+	}
+}
+
+// TestPartitionersCompatibility tests that the Partitioner functions
+// return the same partitions as the librdkafka partitioners.
+func TestPartitionersCompatibility(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	keys := [][]byte{nil, {}, []byte("a"), []byte("key"), []byte("hejsan"),
+		{0x80, 0xff, 0x00, 0x7f, 0x01}}
+	for i := 0; i < 500; i++ {
+		key := make([]byte, rng.Intn(64)+1)
+		rng.Read(key)
+		keys = append(keys, key)
+	}
+
+	for _, name := range []string{"consistent", "consistent_random",
+		"murmur2", "murmur2_random", "fnv1a", "fnv1a_random"} {
+		p, err := NewPartitioner(name)
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+
+		for _, partitionCount := range []int32{1, 2, 3, 7, 64, 1000, 2147483647} {
+			for _, key := range keys {
+				// The random partitioners are not compared for
+				// the keys they partition randomly.
+				if (name == "consistent_random" && len(key) == 0) ||
+					(key == nil && (name == "murmur2_random" || name == "fnv1a_random")) {
+					continue
+				}
+
+				expected := cPartition(name, key, partitionCount)
+				partition := p(key, partitionCount, nil)
+				if partition != expected {
+					t.Errorf("%s: key %v, %d partitions: expected partition %d, got %d",
+						name, key, partitionCount, expected, partition)
+				}
+			}
+		}
+	}
+
+	_, err := NewPartitioner("unknown")
+	if err == nil || err.(Error).Code() != ErrInvalidArg {
+		t.Errorf("Expected ErrInvalidArg for an unknown partitioner, got %v", err)
+	}
+}
+
+// TestRandomPartitioners tests the partitioning of the keys partitioned
+// randomly.
+func TestRandomPartitioners(t *testing.T) {
+	for _, p := range []Partitioner{RandomPartitioner, ConsistentRandomPartitioner,
+		Murmur2RandomPartitioner, FNV1aRandomPartitioner} {
+		seen := make(map[int32]bool)
+		for i := 0; i < 1000; i++ {
+			partition := p(nil, 4, func(partition int32) bool { return true })
+			if partition < 0 || partition >= 4 {
+				t.Fatalf("Expected a partition between 0 and 3, got %d", partition)
+			}
+			seen[partition] = true
+		}
+		if len(seen) != 4 {
+			t.Errorf("Expected all partitions to be returned, got %v", seen)
+		}
+	}
+
+	if partition := ConsistentRandomPartitioner([]byte("key"), 4, nil); partition !=
+		ConsistentPartitioner([]byte("key"), 4, nil) {
+		t.Errorf("Expected keys to be partitioned consistently, got %d", partition)
+	}
+}
+
+// TestPartitionersNoPartitions tests that the partitioners return
+// PartitionAny for topics without partitions.
+func TestPartitionersNoPartitions(t *testing.T) {
+	for name, p := range partitionerNames {
+		for _, partitionCount := range []int32{0, -1} {
+			for _, key := range [][]byte{nil, []byte("key")} {
+				if partition := p(key, partitionCount, nil); partition != PartitionAny {
+					t.Errorf("%s: key %v, %d partitions: expected PartitionAny, got %d",
+						name, key, partitionCount, partition)
+				}
+			}
+		}
+	}
+}
@@ -19,6 +19,8 @@ package kafka
 // Integration tests using the mock cluster.
 
 import (
+	"context"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -223,3 +225,908 @@ func TestParallelConsumerCloses(t *testing.T) {
 		assert.Equal(err1.(Error).String(), "Consumer is already closing")
 	}
 }
+
+// TestConsumerReadBatchRebalance tests that ReadBatch returns messages in order
+// while serving the interleaved rebalance events.
+func TestConsumerReadBatchRebalance(t *testing.T) {
+	assert := assert.New(t)
+
+	mockCluster, err := NewMockCluster(1)
+	assert.NoError(err, "Mock cluster creation should succeed")
+	defer mockCluster.Close()
+
+	topic := "topic"
+	assert.NoError(mockCluster.CreateTopic(topic, 1, 1), "Topic creation should succeed")
+
+	p, err := NewProducer(&ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+	})
+	assert.NoError(err, "Producer creation should succeed")
+	defer p.Close()
+
+	msgcnt := 10
+	for i := 0; i < msgcnt; i++ {
+		err = p.Produce(&Message{
+			TopicPartition: TopicPartition{Topic: &topic, Partition: 0},
+			Value:          []byte(fmt.Sprintf("value%d", i)),
+		}, nil)
+		assert.NoError(err, "Message should be produced")
+	}
+	assert.Zero(p.Flush(10*1000), "Nothing should be unflushed")
+
+	consumer, err := NewConsumer(&ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+		"group.id":          "group",
+		"auto.offset.reset": "earliest",
+	})
+	assert.NoError(err, "Consumer creation should succeed")
+	defer consumer.Close()
+
+	partitionsAssigned := false
+	err = consumer.SubscribeTopics([]string{topic}, func(c *Consumer, e Event) error {
+		if _, ok := e.(AssignedPartitions); ok {
+			partitionsAssigned = true
+		}
+		return nil
+	})
+	assert.NoError(err, "Subscribe should succeed")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var msgs []*Message
+	for len(msgs) < msgcnt && ctx.Err() == nil {
+		batch, _, err := consumer.ReadBatch(ctx, 4, time.Second)
+		assert.LessOrEqual(len(batch), 4, "ReadBatch should not exceed maxMessages")
+		if err != nil {
+			assert.Equal(ErrTimedOut, err.(Error).Code(), "ReadBatch should only time out")
+			continue
+		}
+		msgs = append(msgs, batch...)
+	}
+
+	assert.True(partitionsAssigned, "Rebalance callback should be called")
+	assert.Len(msgs, msgcnt, "All messages should be read")
+	for i, m := range msgs {
+		assert.NoError(m.TopicPartition.Error)
+		assert.Equal(Offset(i), m.TopicPartition.Offset)
+		assert.Equal(fmt.Sprintf("value%d", i), string(m.Value))
+	}
+}
+
+// TestConsumerPartitionQueues tests that each assigned partition's messages
+// are consumed in order from its PartitionQueue and that the queues are
+// torn down on revocation.
+func TestConsumerPartitionQueues(t *testing.T) {
+	assert := assert.New(t)
+
+	mockCluster, err := NewMockCluster(1)
+	assert.NoError(err, "Mock cluster creation should succeed")
+	defer mockCluster.Close()
+
+	topic := "topic"
+	partitionCnt := 3
+	msgcnt := 5
+	assert.NoError(mockCluster.CreateTopic(topic, partitionCnt, 1), "Topic creation should succeed")
+
+	p, err := NewProducer(&ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+	})
+	assert.NoError(err, "Producer creation should succeed")
+	defer p.Close()
+
+	for partition := 0; partition < partitionCnt; partition++ {
+		for i := 0; i < msgcnt; i++ {
+			err = p.Produce(&Message{
+				TopicPartition: TopicPartition{Topic: &topic, Partition: int32(partition)},
+				Value:          []byte(fmt.Sprintf("value%d", i)),
+			}, nil)
+			assert.NoError(err, "Message should be produced")
+		}
+	}
+	assert.Zero(p.Flush(10*1000), "Nothing should be unflushed")
+
+	consumer, err := NewConsumer(&ConfigMap{
+		"bootstrap.servers":          mockCluster.BootstrapServers(),
+		"group.id":                   "group",
+		"auto.offset.reset":          "earliest",
+		"go.partition.queues.enable": true,
+	})
+	assert.NoError(err, "Consumer creation should succeed")
+
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	consumed := make(map[int32][]string)
+	var queues []*PartitionQueue
+
+	err = consumer.SubscribeTopics([]string{topic}, func(c *Consumer, e Event) error {
+		assigned, ok := e.(AssignedPartitions)
+		if !ok {
+			return nil
+		}
+
+		for _, tp := range assigned.Partitions {
+			q, err := c.PartitionQueue(tp)
+			assert.NoError(err, "Partition queue should exist for %v", tp)
+			if err != nil {
+				continue
+			}
+			queues = append(queues, q)
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					m, err := q.ReadMessage(-1)
+					if err != nil {
+						assert.Equal(ErrState, err.(Error).Code(),
+							"ReadMessage should only fail on revocation")
+						return
+					}
+					assert.Equal(q.TopicPartition().Partition, m.TopicPartition.Partition)
+					lock.Lock()
+					consumed[m.TopicPartition.Partition] = append(consumed[m.TopicPartition.Partition], string(m.Value))
+					lock.Unlock()
+				}
+			}()
+		}
+		return nil
+	})
+	assert.NoError(err, "Subscribe should succeed")
+
+	done := func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		for partition := 0; partition < partitionCnt; partition++ {
+			if len(consumed[int32(partition)]) < msgcnt {
+				return false
+			}
+		}
+		return true
+	}
+
+	until := time.Now().Add(60 * time.Second)
+	for !done() && time.Now().Before(until) {
+		ev := consumer.Poll(100)
+		_, isMsg := ev.(*Message)
+		assert.False(isMsg, "Messages should not be returned by Consumer.Poll")
+	}
+
+	// Closing the consumer revokes the partitions, which stops the
+	// partition goroutines.
+	assert.NoError(consumer.Close(), "Consumer closure should succeed")
+	wg.Wait()
+
+	assert.Len(queues, partitionCnt, "A queue should be created per partition")
+	for _, q := range queues {
+		assert.True(q.IsRevoked(), "Queue %v should be revoked", q)
+		select {
+		case <-q.Done():
+		default:
+			assert.Fail("Done() should be closed", "%v", q)
+		}
+	}
+
+	for partition := 0; partition < partitionCnt; partition++ {
+		expected := make([]string, msgcnt)
+		for i := range expected {
+			expected[i] = fmt.Sprintf("value%d", i)
+		}
+		assert.Equal(expected, consumed[int32(partition)],
+			"Partition %d should be consumed in order", partition)
+	}
+}
+
+// testRebalanceListener records the partitions passed to a RebalanceListener
+type testRebalanceListener struct {
+	assigned  []TopicPartition
+	revoked   []TopicPartition
+	lost      []TopicPartition
+	assignErr error
+}
+
+func (l *testRebalanceListener) OnPartitionsAssigned(ctx context.Context, c *Consumer, partitions []TopicPartition) error {
+	l.assigned = append(l.assigned, partitions...)
+	return l.assignErr
+}
+
+func (l *testRebalanceListener) OnPartitionsRevoked(ctx context.Context, c *Consumer, partitions []TopicPartition) error {
+	l.revoked = append(l.revoked, partitions...)
+	return nil
+}
+
+func (l *testRebalanceListener) OnPartitionsLost(ctx context.Context, c *Consumer, partitions []TopicPartition) error {
+	l.lost = append(l.lost, partitions...)
+	return nil
+}
+
+// TestConsumerRebalanceListener tests that a RebalanceListener is notified
+// of assignment changes with both the eager and cooperative protocols, and
+// that stored offsets are committed on revoke.
+func TestConsumerRebalanceListener(t *testing.T) {
+	assert := assert.New(t)
+
+	mockCluster, err := NewMockCluster(1)
+	assert.NoError(err, "Mock cluster creation should succeed")
+	defer mockCluster.Close()
+
+	topic := "topic"
+	assert.NoError(mockCluster.CreateTopic(topic, 2, 1), "Topic creation should succeed")
+
+	p, err := NewProducer(&ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+	})
+	assert.NoError(err, "Producer creation should succeed")
+	defer p.Close()
+
+	msgcnt := 10
+	for i := 0; i < msgcnt; i++ {
+		err = p.Produce(&Message{
+			TopicPartition: TopicPartition{Topic: &topic, Partition: int32(i % 2)},
+			Value:          []byte(fmt.Sprintf("value%d", i)),
+		}, nil)
+		assert.NoError(err, "Message should be produced")
+	}
+	assert.Zero(p.Flush(10*1000), "Nothing should be unflushed")
+
+	for _, strategy := range []string{"range", "cooperative-sticky"} {
+		group := "group-" + strategy
+		consumer, err := NewConsumer(&ConfigMap{
+			"bootstrap.servers":             mockCluster.BootstrapServers(),
+			"group.id":                      group,
+			"auto.offset.reset":             "earliest",
+			"enable.auto.commit":            false,
+			"partition.assignment.strategy": strategy,
+			"go.rebalance.commit.on.revoke": true,
+		})
+		assert.NoError(err, "Consumer creation should succeed")
+
+		listener := &testRebalanceListener{}
+		err = consumer.SubscribeTopicsWithListener(context.Background(), []string{topic}, listener)
+		assert.NoError(err, "Subscribe should succeed")
+
+		deadline := time.Now().Add(30 * time.Second)
+		msgs := 0
+		for msgs < msgcnt && time.Now().Before(deadline) {
+			_, err := consumer.ReadMessage(time.Second)
+			if err == nil {
+				msgs++
+			} else {
+				assert.Equal(ErrTimedOut, err.(Error).Code(), "%s: ReadMessage should only time out", strategy)
+			}
+		}
+		assert.Equal(msgcnt, msgs, "%s: All messages should be read", strategy)
+		assert.Len(listener.assigned, 2, "%s: Both partitions should be assigned", strategy)
+
+		assignment, err := consumer.Assignment()
+		assert.NoError(err)
+		assert.Len(assignment, 2, "%s: The consumer should assign the partitions", strategy)
+
+		assert.NoError(consumer.Unsubscribe())
+		for len(listener.revoked) < 2 && time.Now().Before(deadline) {
+			consumer.Poll(100)
+		}
+		assert.Len(listener.revoked, 2, "%s: Both partitions should be revoked", strategy)
+		assert.Empty(listener.lost, "%s: No partition should be lost", strategy)
+
+		assignment, err = consumer.Assignment()
+		assert.NoError(err)
+		assert.Empty(assignment, "%s: The consumer should unassign the partitions", strategy)
+
+		committed, err := consumer.Committed(listener.assigned, 10*1000)
+		assert.NoError(err)
+		for _, tp := range committed {
+			assert.Equal(Offset(msgcnt/2), tp.Offset,
+				"%s: The offsets should be committed on revoke", strategy)
+		}
+
+		consumer.Close()
+	}
+}
+
+// TestConsumerRebalanceListenerError tests that an error returned by a
+// RebalanceListener is returned by Poll().
+func TestConsumerRebalanceListenerError(t *testing.T) {
+	assert := assert.New(t)
+
+	mockCluster, err := NewMockCluster(1)
+	assert.NoError(err, "Mock cluster creation should succeed")
+	defer mockCluster.Close()
+
+	topic := "topic"
+	assert.NoError(mockCluster.CreateTopic(topic, 1, 1), "Topic creation should succeed")
+
+	consumer, err := NewConsumer(&ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+		"group.id":          "group",
+	})
+	assert.NoError(err, "Consumer creation should succeed")
+	defer consumer.Close()
+
+	err = consumer.SubscribeTopicsWithListener(context.Background(), []string{topic}, nil)
+	assert.Equal(ErrInvalidArg, err.(Error).Code(), "A listener should be required")
+
+	listener := &testRebalanceListener{assignErr: fmt.Errorf("assign failed")}
+	err = consumer.SubscribeTopicsWithListener(context.Background(), []string{topic}, listener)
+	assert.NoError(err, "Subscribe should succeed")
+
+	var listenerErr Error
+	deadline := time.Now().Add(30 * time.Second)
+	for listenerErr.Code() == ErrNoError && time.Now().Before(deadline) {
+		if e, ok := consumer.Poll(100).(Error); ok {
+			listenerErr = e
+		}
+	}
+	assert.Equal(ErrApplication, listenerErr.Code())
+	assert.Equal("assign failed", listenerErr.String())
+
+	assignment, err := consumer.Assignment()
+	assert.NoError(err)
+	assert.Len(assignment, 1, "The partition should be assigned despite the error")
+}
+
+// TestConsumerGroupOffsetsReset tests planning and executing offset resets
+// of an inactive consumer group, and that active groups are refused.
+func TestConsumerGroupOffsetsReset(t *testing.T) {
+	assert := assert.New(t)
+
+	mockCluster, err := NewMockCluster(1)
+	assert.NoError(err, "Mock cluster creation should succeed")
+	defer mockCluster.Close()
+
+	topic := "topic"
+	assert.NoError(mockCluster.CreateTopic(topic, 2, 1), "Topic creation should succeed")
+
+	p, err := NewProducer(&ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+	})
+	assert.NoError(err, "Producer creation should succeed")
+	defer p.Close()
+
+	// The messages of each partition are a minute apart, from base.
+	base := time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+	msgcnt := 20
+	for i := 0; i < msgcnt; i++ {
+		err = p.Produce(&Message{
+			TopicPartition: TopicPartition{Topic: &topic, Partition: int32(i % 2)},
+			Value:          []byte(fmt.Sprintf("value%d", i)),
+			Timestamp:      base.Add(time.Duration(i/2) * time.Minute),
+		}, nil)
+		assert.NoError(err, "Message should be produced")
+	}
+	assert.Zero(p.Flush(10*1000), "Nothing should be unflushed")
+
+	group := "group"
+	consumer, err := NewConsumer(&ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+		"group.id":          group,
+	})
+	assert.NoError(err, "Consumer creation should succeed")
+
+	a, err := NewAdminClientFromConsumer(consumer)
+	assert.NoError(err, "AdminClient creation should succeed")
+	defer a.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// The group is active while the consumer is subscribed
+	assert.NoError(consumer.Subscribe(topic, nil))
+	deadline := time.Now().Add(30 * time.Second)
+	for time.Now().Before(deadline) {
+		consumer.Poll(100)
+		assignment, _ := consumer.Assignment()
+		if len(assignment) == 2 {
+			break
+		}
+	}
+	_, err = a.PlanConsumerGroupOffsetsReset(ctx, group,
+		[]TopicPartition{{Topic: &topic, Partition: PartitionAny}},
+		OffsetResetSpec{Mode: OffsetResetToEarliest})
+	if err == nil {
+		t.Fatalf("Expected active groups to be refused")
+	}
+	if err.(Error).Code() == ErrUnsupportedFeature {
+		t.Skipf("Mock cluster does not support DescribeGroups: %s", err)
+	}
+	assert.Equal(ErrNonEmptyGroup, err.(Error).Code(), "Active groups should be refused")
+
+	_, err = consumer.CommitOffsets([]TopicPartition{
+		{Topic: &topic, Partition: 0, Offset: 5},
+		{Topic: &topic, Partition: 1, Offset: 5}})
+	assert.NoError(err, "Offsets should be committed")
+	assert.NoError(consumer.Unsubscribe())
+
+	testcases := []struct {
+		spec     OffsetResetSpec
+		expected [2]Offset
+	}{
+		{OffsetResetSpec{Mode: OffsetResetToEarliest}, [2]Offset{0, 0}},
+		{OffsetResetSpec{Mode: OffsetResetToLatest}, [2]Offset{10, 10}},
+		{OffsetResetSpec{Mode: OffsetResetShiftBy, Shift: -3}, [2]Offset{2, 2}},
+		{OffsetResetSpec{Mode: OffsetResetShiftBy, Shift: 100}, [2]Offset{10, 10}},
+		{OffsetResetSpec{Mode: OffsetResetToOffset, Offset: 7}, [2]Offset{7, 7}},
+		{OffsetResetSpec{Mode: OffsetResetFromFile, Offsets: []TopicPartition{
+			{Topic: &topic, Partition: 0, Offset: 1},
+			{Topic: &topic, Partition: 1, Offset: 8}}}, [2]Offset{1, 8}},
+	}
+
+	byTimestamp, err := a.listOffsetsOf(ctx, []TopicPartition{{Topic: &topic, Partition: 0}},
+		NewOffsetSpecForTimestamp(base.UnixMilli()))
+	if err == nil && byTimestamp[topicPartitionKey{topic, 0}] == 0 {
+		testcases = append(testcases, []struct {
+			spec     OffsetResetSpec
+			expected [2]Offset
+		}{
+			{OffsetResetSpec{Mode: OffsetResetToDatetime, Datetime: base.Add(3 * time.Minute)}, [2]Offset{3, 3}},
+			{OffsetResetSpec{Mode: OffsetResetToDatetime, Datetime: base.Add(150 * time.Second)}, [2]Offset{3, 3}},
+			// No records at or after the datetime: reset to the latest offset
+			{OffsetResetSpec{Mode: OffsetResetToDatetime, Datetime: time.Now().Add(time.Hour)}, [2]Offset{10, 10}},
+			{OffsetResetSpec{Mode: OffsetResetByDuration, Duration: 2 * time.Hour}, [2]Offset{0, 0}},
+			{OffsetResetSpec{Mode: OffsetResetByDuration, Duration: time.Hour - 390*time.Second}, [2]Offset{7, 7}},
+		}...)
+	} else {
+		t.Logf("Mock cluster does not support offset lookups by timestamp (%v): "+
+			"skipping the datetime and duration resets", err)
+	}
+
+	for _, tc := range testcases {
+		plan, err := a.PlanConsumerGroupOffsetsReset(ctx, group,
+			[]TopicPartition{{Topic: &topic, Partition: PartitionAny}}, tc.spec)
+		assert.NoError(err, "%s: Plan should succeed", tc.spec.Mode)
+		if !assert.Len(plan.Partitions, 2, "%s: Both partitions should be planned", tc.spec.Mode) {
+			continue
+		}
+		for i, pr := range plan.Partitions {
+			assert.Equal(int32(i), pr.Partition)
+			assert.Equal(Offset(5), pr.CurrentOffset, "%s: Plan should not alter offsets", tc.spec.Mode)
+			assert.Equal(tc.expected[i], pr.NewOffset, "%s: Unexpected planned offset", tc.spec.Mode)
+		}
+	}
+
+	// Partitions given both through PartitionAny and explicitly are
+	// planned once.
+	plan, err := a.PlanConsumerGroupOffsetsReset(ctx, group,
+		[]TopicPartition{
+			{Topic: &topic, Partition: PartitionAny},
+			{Topic: &topic, Partition: 1},
+			{Topic: &topic, Partition: PartitionAny},
+			{Topic: &topic, Partition: 1}},
+		OffsetResetSpec{Mode: OffsetResetToOffset, Offset: 7})
+	assert.NoError(err, "Plan of duplicate partitions should succeed")
+	assert.Len(plan.Partitions, 2, "Duplicate partitions should be planned once")
+
+	plan, err = a.PlanConsumerGroupOffsetsReset(ctx, group, nil,
+		OffsetResetSpec{Mode: OffsetResetToOffset, Offset: 3})
+	assert.NoError(err, "Plan of the committed partitions should succeed")
+	assert.Len(plan.Partitions, 2, "The committed partitions should be planned")
+
+	altered, err := a.ExecuteConsumerGroupOffsetsReset(ctx, plan)
+	assert.NoError(err, "Execute should succeed")
+	for _, tp := range altered {
+		assert.NoError(tp.Error)
+	}
+
+	committed, err := consumer.Committed([]TopicPartition{
+		{Topic: &topic, Partition: 0},
+		{Topic: &topic, Partition: 1}}, 10*1000)
+	assert.NoError(err)
+	for _, tp := range committed {
+		assert.Equal(Offset(3), tp.Offset, "The offsets should be reset")
+	}
+
+	consumer.Close()
+}
+
+// TestAdminClientDeleteConsumerGroupOffsets tests deleting the committed
+// offsets of a single topic of a consumer group.
+func TestAdminClientDeleteConsumerGroupOffsets(t *testing.T) {
+	assert := assert.New(t)
+
+	mockCluster, err := NewMockCluster(1)
+	assert.NoError(err, "Mock cluster creation should succeed")
+	defer mockCluster.Close()
+
+	topic1 := "topic1"
+	topic2 := "topic2"
+	assert.NoError(mockCluster.CreateTopic(topic1, 1, 1), "Topic creation should succeed")
+	assert.NoError(mockCluster.CreateTopic(topic2, 1, 1), "Topic creation should succeed")
+
+	group := "group"
+	consumer, err := NewConsumer(&ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+		"group.id":          group,
+	})
+	assert.NoError(err, "Consumer creation should succeed")
+	defer consumer.Close()
+
+	partitions := []TopicPartition{
+		{Topic: &topic1, Partition: 0, Offset: 1},
+		{Topic: &topic2, Partition: 0, Offset: 2}}
+	_, err = consumer.CommitOffsets(partitions)
+	assert.NoError(err, "Offsets should be committed")
+
+	a, err := NewAdminClientFromConsumer(consumer)
+	assert.NoError(err, "AdminClient creation should succeed")
+	defer a.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	res, err := a.DeleteConsumerGroupOffsets(ctx, []ConsumerGroupTopicPartitions{
+		{Group: group, Partitions: []TopicPartition{{Topic: &topic1, Partition: 0}}}})
+	if err != nil && err.(Error).Code() == ErrUnsupportedFeature {
+		t.Skipf("Mock cluster does not support OffsetDelete: %s", err)
+	}
+	assert.NoError(err, "DeleteConsumerGroupOffsets should succeed")
+	assert.Len(res.ConsumerGroupsTopicPartitions, 1)
+	assert.Equal(group, res.ConsumerGroupsTopicPartitions[0].Group)
+	assert.Len(res.ConsumerGroupsTopicPartitions[0].Partitions, 1)
+	for _, tp := range res.ConsumerGroupsTopicPartitions[0].Partitions {
+		assert.Equal(topic1, *tp.Topic)
+		assert.NoError(tp.Error, "The offset should be deleted")
+	}
+
+	committed, err := consumer.Committed([]TopicPartition{
+		{Topic: &topic1, Partition: 0},
+		{Topic: &topic2, Partition: 0}}, 10*1000)
+	assert.NoError(err)
+	assert.Equal(OffsetInvalid, committed[0].Offset, "The offset of topic1 should be deleted")
+	assert.Equal(Offset(2), committed[1].Offset, "The offset of topic2 should be kept")
+}
+
+// TestProducerRunInTransaction tests that RunInTransaction() commits the
+// transaction of a successful callback and aborts the others.
+func TestProducerRunInTransaction(t *testing.T) {
+	assert := assert.New(t)
+
+	mockCluster, err := NewMockCluster(3)
+	assert.NoError(err, "Mock cluster creation should succeed")
+	defer mockCluster.Close()
+
+	topic := "topic"
+	assert.NoError(mockCluster.CreateTopic(topic, 1, 3), "Topic creation should succeed")
+
+	p, err := NewProducer(&ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+		"transactional.id":  "txn",
+	})
+	assert.NoError(err, "Producer creation should succeed")
+	defer p.Close()
+
+	go func() {
+		for range p.Events() {
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	assert.NoError(p.InitTransactions(ctx), "InitTransactions should succeed")
+
+	produce := func(value string) func(txn *Transaction) error {
+		return func(txn *Transaction) error {
+			return txn.Produce(&Message{
+				TopicPartition: TopicPartition{Topic: &topic, Partition: 0},
+				Value:          []byte(value),
+			}, nil)
+		}
+	}
+
+	assert.NoError(p.RunInTransaction(ctx, produce("committed1")))
+
+	appErr := fmt.Errorf("application error")
+	err = p.RunInTransaction(ctx, func(txn *Transaction) error {
+		err := produce("aborted")(txn)
+		if err != nil {
+			return err
+		}
+		return appErr
+	})
+	assert.Equal(appErr, err, "The callback's error should be returned")
+
+	assert.NoError(p.RunInTransaction(ctx, produce("committed2")),
+		"A new transaction should succeed after an abort")
+
+	consumer, err := NewConsumer(&ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+		"group.id":          "group",
+		"auto.offset.reset": "earliest",
+		"isolation.level":   "read_committed",
+	})
+	assert.NoError(err, "Consumer creation should succeed")
+	defer consumer.Close()
+	assert.NoError(consumer.Subscribe(topic, nil))
+
+	var values []string
+	deadline := time.Now().Add(30 * time.Second)
+	for len(values) < 2 && time.Now().Before(deadline) {
+		msg, err := consumer.ReadMessage(time.Second)
+		if err == nil {
+			values = append(values, string(msg.Value))
+		}
+	}
+	assert.Equal([]string{"committed1", "committed2"}, values,
+		"Only the committed messages should be read")
+}
+
+// TestProducerProduceSyncAsync tests that ProduceSync() and ProduceAsync()
+// return the delivery reports of the produced messages.
+func TestProducerProduceSyncAsync(t *testing.T) {
+	assert := assert.New(t)
+
+	mockCluster, err := NewMockCluster(1)
+	assert.NoError(err, "Mock cluster creation should succeed")
+	defer mockCluster.Close()
+
+	topic := "topic"
+	assert.NoError(mockCluster.CreateTopic(topic, 1, 1), "Topic creation should succeed")
+
+	p, err := NewProducer(&ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+	})
+	assert.NoError(err, "Producer creation should succeed")
+	defer p.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	msg, err := p.ProduceSync(ctx, &Message{
+		TopicPartition: TopicPartition{Topic: &topic, Partition: 0},
+		Value:          []byte("sync"),
+	})
+	assert.NoError(err, "ProduceSync should succeed")
+	assert.Equal(Offset(0), msg.TopicPartition.Offset)
+
+	msgcnt := 100
+	futures := make([]*DeliveryFuture, msgcnt)
+	for i := range futures {
+		futures[i], err = p.ProduceAsync(&Message{
+			TopicPartition: TopicPartition{Topic: &topic, Partition: 0},
+			Value:          []byte(fmt.Sprintf("value%d", i)),
+			Opaque:         i,
+		})
+		assert.NoError(err, "ProduceAsync should succeed")
+	}
+
+	for i, f := range futures {
+		msg, err := f.Wait(ctx)
+		assert.NoError(err, "Message %d should be delivered", i)
+		assert.Equal(i, msg.Opaque, "The Opaque should be restored")
+		assert.Equal(Offset(i+1), msg.TopicPartition.Offset)
+		assert.Equal(fmt.Sprintf("value%d", i), string(msg.Value))
+	}
+
+	select {
+	case ev := <-p.Events():
+		assert.Fail("No event should be emitted", "Got %v", ev)
+	default:
+	}
+}
+
+// TestProducerGoPartitioner tests the go.partitioner and
+// go.topic.partitioners Go partitioners.
+func TestProducerGoPartitioner(t *testing.T) {
+	assert := assert.New(t)
+
+	mockCluster, err := NewMockCluster(1)
+	assert.NoError(err, "Mock cluster creation should succeed")
+	defer mockCluster.Close()
+
+	topic := "topic"
+	tenantTopic := "tenant"
+	assert.NoError(mockCluster.CreateTopic(topic, 4, 1), "Topic creation should succeed")
+	assert.NoError(mockCluster.CreateTopic(tenantTopic, 4, 1), "Topic creation should succeed")
+
+	var lock sync.Mutex
+	topics := make(map[string]bool)
+	// Partitions by key length, or panics for a null key.
+	byKeyLength := func(topic string, key []byte, partitionCount int32, available func(int32) bool) int32 {
+		lock.Lock()
+		topics[topic] = true
+		lock.Unlock()
+		if key == nil {
+			panic("null key")
+		}
+		return int32(len(key)) % partitionCount
+	}
+	// Partitions to the last available partition.
+	lastAvailable := func(topic string, key []byte, partitionCount int32, available func(int32) bool) int32 {
+		for partition := partitionCount - 1; partition >= 0; partition-- {
+			if available(partition) {
+				return partition
+			}
+		}
+		return PartitionAny
+	}
+
+	p, err := NewProducer(&ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+		"go.partitioner":    PartitionerFunc(byKeyLength),
+		"go.topic.partitioners": map[string]PartitionerFunc{
+			tenantTopic: lastAvailable,
+		},
+	})
+	assert.NoError(err, "Producer creation should succeed")
+	defer p.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	for _, key := range []string{"", "a", "ab", "abc", "abcd"} {
+		msg, err := p.ProduceSync(ctx, &Message{
+			TopicPartition: TopicPartition{Topic: &topic, Partition: PartitionAny},
+			Key:            []byte(key),
+		})
+		assert.NoError(err, "Message with key %q should be delivered", key)
+		assert.Equal(int32(len(key)%4), msg.TopicPartition.Partition)
+
+		msg, err = p.ProduceSync(ctx, &Message{
+			TopicPartition: TopicPartition{Topic: &tenantTopic, Partition: PartitionAny},
+			Key:            []byte(key),
+		})
+		assert.NoError(err, "Message with key %q should be delivered", key)
+		assert.Equal(int32(3), msg.TopicPartition.Partition)
+	}
+
+	lock.Lock()
+	assert.Equal(map[string]bool{topic: true}, topics,
+		"The default partitioner should only be called for its topics")
+	lock.Unlock()
+
+	// A partitioner panic fails the message.
+	_, err = p.ProduceSync(ctx, &Message{
+		TopicPartition: TopicPartition{Topic: &topic, Partition: PartitionAny},
+	})
+	assert.Error(err)
+	assert.Equal(ErrUnknownPartition, err.(Error).Code())
+
+	// Explicit partitions are kept.
+	msg, err := p.ProduceSync(ctx, &Message{
+		TopicPartition: TopicPartition{Topic: &tenantTopic, Partition: 1},
+	})
+	assert.NoError(err)
+	assert.Equal(int32(1), msg.TopicPartition.Partition)
+}
+
+// testInterceptor is a ProducerInterceptor and ConsumerInterceptor
+// recording the intercepted messages and offsets.
+type testInterceptor struct {
+	lock     sync.Mutex
+	name     string
+	sent     int
+	acks     []*Message
+	consumed []*Message
+	commits  [][]TopicPartition
+}
+
+func (ic *testInterceptor) OnSend(msg *Message) {
+	ic.lock.Lock()
+	defer ic.lock.Unlock()
+	ic.sent++
+	msg.Headers = append(msg.Headers, Header{Key: ic.name, Value: []byte("stamp")})
+}
+
+func (ic *testInterceptor) OnAcknowledgement(msg *Message) {
+	ic.lock.Lock()
+	defer ic.lock.Unlock()
+	ic.acks = append(ic.acks, msg)
+}
+
+func (ic *testInterceptor) OnConsume(msg *Message) {
+	ic.lock.Lock()
+	defer ic.lock.Unlock()
+	ic.consumed = append(ic.consumed, msg)
+}
+
+func (ic *testInterceptor) OnCommit(offsets []TopicPartition, err error) {
+	ic.lock.Lock()
+	defer ic.lock.Unlock()
+	if err == nil {
+		ic.commits = append(ic.commits, offsets)
+	}
+}
+
+// TestProducerConsumerInterceptors tests the go.producer.interceptors and
+// go.consumer.interceptors interceptor chains.
+func TestProducerConsumerInterceptors(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewProducer(&ConfigMap{"go.producer.interceptors": []interface{}{}})
+	assert.Error(err, "An invalid interceptors type should fail")
+	_, err = NewConsumer(&ConfigMap{"group.id": "test",
+		"go.consumer.interceptors": []ConsumerInterceptor{nil}})
+	assert.Error(err, "A nil interceptor should fail")
+
+	mockCluster, err := NewMockCluster(1)
+	assert.NoError(err, "Mock cluster creation should succeed")
+	defer mockCluster.Close()
+
+	topic := "topic"
+	assert.NoError(mockCluster.CreateTopic(topic, 1, 1), "Topic creation should succeed")
+
+	first := &testInterceptor{name: "first"}
+	second := &testInterceptor{name: "second"}
+
+	p, err := NewProducer(&ConfigMap{
+		"bootstrap.servers":        mockCluster.BootstrapServers(),
+		"go.producer.interceptors": []ProducerInterceptor{first, second},
+	})
+	assert.NoError(err, "Producer creation should succeed")
+	defer p.Close()
+
+	msgcnt := 10
+	for i := 0; i < msgcnt; i++ {
+		msg := &Message{
+			TopicPartition: TopicPartition{Topic: &topic, Partition: 0},
+			Value:          []byte(fmt.Sprintf("value%d", i)),
+			Headers:        []Header{{Key: "app", Value: []byte("header")}},
+		}
+		if i%2 == 0 {
+			assert.NoError(p.Produce(msg, nil))
+		} else {
+			assert.Zero(p.produceBatch([]*Message{msg}, 0, nil))
+		}
+		assert.Len(msg.Headers, 1, "The produced message should not be modified")
+	}
+	assert.Zero(p.Flush(10*1000), "All messages should be delivered")
+
+	for _, ic := range []*testInterceptor{first, second} {
+		ic.lock.Lock()
+		assert.Equal(msgcnt, ic.sent)
+		assert.Len(ic.acks, msgcnt)
+		for i, msg := range ic.acks {
+			assert.NoError(msg.TopicPartition.Error)
+			assert.Equal(Offset(i), msg.TopicPartition.Offset)
+			assert.Equal(topic, *msg.TopicPartition.Topic)
+			assert.Len(msg.Headers, 3)
+		}
+		ic.lock.Unlock()
+	}
+
+	consumerInterceptor := &testInterceptor{}
+	c, err := NewConsumer(&ConfigMap{
+		"bootstrap.servers":        mockCluster.BootstrapServers(),
+		"group.id":                 "test",
+		"auto.offset.reset":        "earliest",
+		"enable.auto.commit":       false,
+		"go.consumer.interceptors": []ConsumerInterceptor{consumerInterceptor},
+	})
+	assert.NoError(err, "Consumer creation should succeed")
+	defer c.Close()
+	assert.NoError(c.Subscribe(topic, nil))
+
+	for i := 0; i < msgcnt; i++ {
+		msg, err := c.ReadMessage(10 * time.Second)
+		assert.NoError(err, "Message %d should be consumed", i)
+		if err != nil {
+			break
+		}
+		assert.Equal([]Header{
+			{Key: "app", Value: []byte("header")},
+			{Key: "first", Value: []byte("stamp")},
+			{Key: "second", Value: []byte("stamp")},
+		}, msg.Headers, "The interceptors should add headers in order")
+	}
+
+	_, err = c.Commit()
+	assert.NoError(err)
+
+	// OnCommit is called from a librdkafka thread
+	assert.Eventually(func() bool {
+		consumerInterceptor.lock.Lock()
+		defer consumerInterceptor.lock.Unlock()
+		return len(consumerInterceptor.commits) > 0
+	}, 5*time.Second, 10*time.Millisecond, "OnCommit should be called")
+
+	consumerInterceptor.lock.Lock()
+	defer consumerInterceptor.lock.Unlock()
+	assert.Len(consumerInterceptor.consumed, msgcnt)
+	for i, msg := range consumerInterceptor.consumed {
+		assert.Equal(fmt.Sprintf("value%d", i), string(msg.Value))
+		assert.Equal(Offset(i), msg.TopicPartition.Offset)
+	}
+	assert.Len(consumerInterceptor.commits, 1)
+	if len(consumerInterceptor.commits) == 1 {
+		assert.Equal(Offset(msgcnt), consumerInterceptor.commits[0][0].Offset)
+	}
+}
@@ -0,0 +1,326 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kafka
+
+import (
+	"fmt"
+	"time"
+	"unsafe"
+)
+
+/*
+#include <stdint.h>
+#include "select_rdkafka.h"
+#include "glue_rdkafka.h"
+
+// Exported by the interceptor* functions below.
+extern void interceptorOnAcknowledgement(rd_kafka_message_t *rkmessage,
+                                         uintptr_t cgoid);
+extern void interceptorOnConsume(rd_kafka_message_t *rkmessage,
+                                 uintptr_t cgoid);
+extern void interceptorOnCommit(rd_kafka_topic_partition_list_t *offsets,
+                                rd_kafka_resp_err_t err, uintptr_t cgoid);
+
+// As this file exports Go functions its C functions must be static.
+// The ic_opaque of the interceptors is the cgoid of the interceptor chain.
+
+static rd_kafka_resp_err_t on_acknowledgement (rd_kafka_t *rk,
+                                               rd_kafka_message_t *rkmessage,
+                                               void *ic_opaque) {
+  interceptorOnAcknowledgement(rkmessage, (uintptr_t)ic_opaque);
+  return RD_KAFKA_RESP_ERR_NO_ERROR;
+}
+
+static rd_kafka_resp_err_t on_consume (rd_kafka_t *rk,
+                                       rd_kafka_message_t *rkmessage,
+                                       void *ic_opaque) {
+  interceptorOnConsume(rkmessage, (uintptr_t)ic_opaque);
+  return RD_KAFKA_RESP_ERR_NO_ERROR;
+}
+
+static rd_kafka_resp_err_t on_commit (
+    rd_kafka_t *rk, const rd_kafka_topic_partition_list_t *offsets,
+    rd_kafka_resp_err_t err, void *ic_opaque) {
+  interceptorOnCommit((rd_kafka_topic_partition_list_t *)offsets, err,
+                      (uintptr_t)ic_opaque);
+  return RD_KAFKA_RESP_ERR_NO_ERROR;
+}
+
+// Interceptors can only be added to the client instance from on_new().
+static rd_kafka_resp_err_t on_new (rd_kafka_t *rk, const rd_kafka_conf_t *conf,
+                                   void *ic_opaque,
+                                   char *errstr, size_t errstr_size) {
+  rd_kafka_interceptor_add_on_acknowledgement(rk, "confluent-kafka-go",
+                                              on_acknowledgement, ic_opaque);
+  rd_kafka_interceptor_add_on_consume(rk, "confluent-kafka-go",
+                                      on_consume, ic_opaque);
+  rd_kafka_interceptor_add_on_commit(rk, "confluent-kafka-go",
+                                     on_commit, ic_opaque);
+  return RD_KAFKA_RESP_ERR_NO_ERROR;
+}
+
+static rd_kafka_resp_err_t add_interceptors (rd_kafka_conf_t *conf,
+                                             uintptr_t cgoid) {
+  return rd_kafka_conf_interceptor_add_on_new(conf, "confluent-kafka-go",
+                                              on_new, (void *)cgoid);
+}
+
+static void glue_msg_setup (glue_msg_t *gMsg, rd_kafka_message_t *rkmessage) {
+  gMsg->msg = rkmessage;
+  gMsg->ts = rd_kafka_message_timestamp(rkmessage, &gMsg->tstype);
+  gMsg->want_hdrs = 1;
+}
+*/
+import "C"
+
+// ProducerInterceptor intercepts the messages produced by a Producer,
+// see the `go.producer.interceptors` configuration property.
+//
+// The interceptors must not block or execute for prolonged periods of
+// time, nor call the Producer's methods.
+// A panic in an interceptor is recovered and ignored.
+type ProducerInterceptor interface {
+	// OnSend is called with each message produced, before it is
+	// enqueued, on the producing go-routine.
+	// msg is a copy of the produced message, whose Headers may be
+	// modified, e.g., to add headers.
+	OnSend(msg *Message)
+
+	// OnAcknowledgement is called with each message enqueued by the
+	// producer, once it is delivered or permanently fails delivery, or
+	// when it can not be partitioned. msg.TopicPartition.Error is the
+	// delivery error, if any. Its Opaque is not set.
+	// OnAcknowledgement may be called from librdkafka threads, before the
+	// delivery report is emitted.
+	OnAcknowledgement(msg *Message)
+}
+
+// ConsumerInterceptor intercepts the messages consumed and the offsets
+// committed by a Consumer, see the `go.consumer.interceptors`
+// configuration property.
+//
+// The interceptors must not block or execute for prolonged periods of
+// time, nor call the Consumer's methods.
+// A panic in an interceptor is recovered and ignored.
+type ConsumerInterceptor interface {
+	// OnConsume is called with each consumed message, before it is
+	// returned to the application. msg is a copy of the message.
+	OnConsume(msg *Message)
+
+	// OnCommit is called when an offset commit, including an automatic
+	// commit, completes or fails. err is the commit error, if any, and
+	// each partition's Error is the partition's commit error, if any.
+	// OnCommit is called from librdkafka threads.
+	OnCommit(offsets []TopicPartition, err error)
+}
+
+// interceptors is the cgoif container of the interceptor chains of a
+// handle, in the global cgo map: the interceptor callbacks are only passed
+// its cgoid, as ic_opaque.
+type interceptors struct {
+	producer []ProducerInterceptor
+	consumer []ConsumerInterceptor
+}
+
+// extractProducerInterceptors extracts the go.producer.interceptors
+// configuration property.
+func (m ConfigMap) extractProducerInterceptors() ([]ProducerInterceptor, error) {
+	v, err := m.extract("go.producer.interceptors", nil)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	chain, ok := v.([]ProducerInterceptor)
+	if !ok {
+		return nil, newErrorFromString(ErrInvalidArg,
+			fmt.Sprintf("go.producer.interceptors expects type []kafka.ProducerInterceptor, not %T", v))
+	}
+	for _, interceptor := range chain {
+		if interceptor == nil {
+			return nil, newErrorFromString(ErrInvalidArg,
+				"go.producer.interceptors: nil interceptor")
+		}
+	}
+
+	return chain, nil
+}
+
+// extractConsumerInterceptors extracts the go.consumer.interceptors
+// configuration property.
+func (m ConfigMap) extractConsumerInterceptors() ([]ConsumerInterceptor, error) {
+	v, err := m.extract("go.consumer.interceptors", nil)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	chain, ok := v.([]ConsumerInterceptor)
+	if !ok {
+		return nil, newErrorFromString(ErrInvalidArg,
+			fmt.Sprintf("go.consumer.interceptors expects type []kafka.ConsumerInterceptor, not %T", v))
+	}
+	for _, interceptor := range chain {
+		if interceptor == nil {
+			return nil, newErrorFromString(ErrInvalidArg,
+				"go.consumer.interceptors: nil interceptor")
+		}
+	}
+
+	return chain, nil
+}
+
+// setupInterceptors registers the interceptor chains of the handle in the
+// global cgo map and adds the librdkafka interceptors calling them to
+// cConf, if there are any interceptors.
+func (h *handle) setupInterceptors(cConf *C.rd_kafka_conf_t, producer []ProducerInterceptor, consumer []ConsumerInterceptor) error {
+	if len(producer) == 0 && len(consumer) == 0 {
+		return nil
+	}
+
+	h.interceptors = &interceptors{producer: producer, consumer: consumer}
+	cgoid := h.globalCgoPut(h.interceptors)
+
+	cErr := C.add_interceptors(cConf, C.uintptr_t(cgoid))
+	if cErr != C.RD_KAFKA_RESP_ERR_NO_ERROR {
+		return newError(cErr)
+	}
+
+	return nil
+}
+
+// onSend returns a copy of msg, with a copy of its Headers, passed to the
+// OnSend() of each producer interceptor.
+func (ic *interceptors) onSend(msg *Message) *Message {
+	m := *msg
+	if msg.Headers != nil {
+		m.Headers = make([]Header, len(msg.Headers))
+		copy(m.Headers, msg.Headers)
+	}
+
+	for _, interceptor := range ic.producer {
+		func() {
+			defer func() { recover() }()
+			interceptor.OnSend(&m)
+		}()
+	}
+
+	return &m
+}
+
+// getInterceptors returns the interceptors of cgoid, if found.
+func getInterceptors(cgoid C.uintptr_t) (*interceptors, bool) {
+	cg, found := globalCgoGet(uintptr(cgoid))
+	if !found {
+		return nil, false
+	}
+	return cg.(*interceptors), true
+}
+
+// newInterceptedMessage creates a new message object from the C
+// rd_kafka_message_t of an interceptor callback.
+// Unlike newMessageFromC() it includes all the message fields and does
+// not use the handle, as the client instance may be being destroyed.
+func newInterceptedMessage(rkmessage *C.rd_kafka_message_t) *Message {
+	var gMsg C.glue_msg_t
+	C.glue_msg_setup(&gMsg, rkmessage)
+
+	msg := &Message{}
+
+	if gMsg.ts != -1 {
+		ts := int64(gMsg.ts)
+		msg.TimestampType = TimestampType(gMsg.tstype)
+		msg.Timestamp = time.Unix(ts/1000, (ts%1000)*1000000)
+	}
+
+	chdrsToTmphdrs(&gMsg)
+	if gMsg.tmphdrsCnt > 0 {
+		setupHeadersFromGlueMsg(msg, &gMsg)
+	}
+
+	if rkmessage.rkt != nil {
+		topic := C.GoString(C.rd_kafka_topic_name(rkmessage.rkt))
+		msg.TopicPartition.Topic = &topic
+	}
+	msg.TopicPartition.Partition = int32(rkmessage.partition)
+	msg.TopicPartition.Offset = Offset(rkmessage.offset)
+	if rkmessage.payload != nil {
+		msg.Value = C.GoBytes(unsafe.Pointer(rkmessage.payload), C.int(rkmessage.len))
+	}
+	if rkmessage.key != nil {
+		msg.Key = C.GoBytes(unsafe.Pointer(rkmessage.key), C.int(rkmessage.key_len))
+	}
+	if rkmessage.err != 0 {
+		msg.TopicPartition.Error = newError(rkmessage.err)
+	}
+
+	return msg
+}
+
+//export interceptorOnAcknowledgement
+func interceptorOnAcknowledgement(rkmessage *C.rd_kafka_message_t, cgoid C.uintptr_t) {
+	ic, found := getInterceptors(cgoid)
+	if !found || len(ic.producer) == 0 {
+		return
+	}
+
+	msg := newInterceptedMessage(rkmessage)
+	for _, interceptor := range ic.producer {
+		func() {
+			defer func() { recover() }()
+			interceptor.OnAcknowledgement(msg)
+		}()
+	}
+}
+
+//export interceptorOnConsume
+func interceptorOnConsume(rkmessage *C.rd_kafka_message_t, cgoid C.uintptr_t) {
+	ic, found := getInterceptors(cgoid)
+	if !found || len(ic.consumer) == 0 {
+		return
+	}
+
+	msg := newInterceptedMessage(rkmessage)
+	for _, interceptor := range ic.consumer {
+		func() {
+			defer func() { recover() }()
+			interceptor.OnConsume(msg)
+		}()
+	}
+}
+
+//export interceptorOnCommit
+func interceptorOnCommit(offsets *C.rd_kafka_topic_partition_list_t, cErr C.rd_kafka_resp_err_t, cgoid C.uintptr_t) {
+	ic, found := getInterceptors(cgoid)
+	if !found || len(ic.consumer) == 0 {
+		return
+	}
+
+	var partitions []TopicPartition
+	if offsets != nil {
+		partitions = newTopicPartitionsFromCparts(offsets)
+	}
+	var err error
+	if cErr != C.RD_KAFKA_RESP_ERR_NO_ERROR {
+		err = newError(cErr)
+	}
+
+	for _, interceptor := range ic.consumer {
+		func() {
+			defer func() { recover() }()
+			interceptor.OnCommit(partitions, err)
+		}()
+	}
+}
@@ -318,6 +318,13 @@ func (tps TopicPartitions) Swap(i, j int) {
 	tps[i], tps[j] = tps[j], tps[i]
 }
 
+// topicPartitionKey identifies a topic partition in maps keyed by
+// partition, such as Consumer.partitionQueues
+type topicPartitionKey struct {
+	topic     string
+	partition int32
+}
+
 // Node represents a Kafka broker.
 type Node struct {
 	// Node id.
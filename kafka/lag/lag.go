@@ -0,0 +1,167 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package lag monitors the lag of consumer groups.
+//
+// A Monitor periodically combines the committed offsets of consumer groups,
+// from AdminClient.ListConsumerGroupOffsets(), with the latest offsets of
+// their partitions, from AdminClient.ListOffsets(), into offset lag and
+// time lag per group, topic and partition.
+//
+// The time lag of a partition is the age of the group's next record to
+// consume. It is interpolated from the history of the partition's latest
+// offsets as sampled by the Monitor, using the timestamps of the partitions'
+// head records if Config.HeadTimestamps is set.
+package lag
+
+import (
+	"sort"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// PartitionLag is the lag of a consumer group on a partition.
+type PartitionLag struct {
+	Topic     string
+	Partition int32
+	// CommittedOffset is the group's committed offset.
+	CommittedOffset kafka.Offset
+	// LatestOffset is the partition's latest offset (high watermark).
+	LatestOffset kafka.Offset
+	// Lag is the number of records between the committed offset and the
+	// latest offset, or -1 if unknown.
+	Lag int64
+	// TimeLag is the age of the record at the committed offset,
+	// 0 if the group is up to date, or -1 if unknown.
+	// It is interpolated from the sampled latest offsets: it is
+	// underestimated until the Monitor's history covers the
+	// committed offset.
+	TimeLag time.Duration
+	// Error is the error of the partition, if any.
+	Error error
+}
+
+// GroupLag is the lag of a consumer group.
+type GroupLag struct {
+	Group string
+	// Partitions is the lag of each partition the group committed
+	// offsets for, ordered by topic and partition.
+	Partitions []PartitionLag
+	// Error is the error of the group's offsets, if any.
+	Error error
+}
+
+// TopicLag returns the total lag of the group per topic.
+// Partitions with an unknown lag are ignored.
+func (g GroupLag) TopicLag() map[string]int64 {
+	lag := make(map[string]int64)
+	for _, p := range g.Partitions {
+		if p.Lag > 0 {
+			lag[p.Topic] += p.Lag
+		} else if _, found := lag[p.Topic]; !found {
+			lag[p.Topic] = 0
+		}
+	}
+	return lag
+}
+
+// MaxTimeLag returns the highest time lag of the group's partitions.
+func (g GroupLag) MaxTimeLag() time.Duration {
+	var max time.Duration
+	for _, p := range g.Partitions {
+		if p.TimeLag > max {
+			max = p.TimeLag
+		}
+	}
+	return max
+}
+
+// Snapshot is the lag of the monitored consumer groups at a point in time.
+type Snapshot struct {
+	Time time.Time
+	// Groups is the lag of each group, ordered by group id.
+	Groups []GroupLag
+}
+
+// Group returns the lag of group, if found in the snapshot.
+func (s *Snapshot) Group(group string) (GroupLag, bool) {
+	for _, g := range s.Groups {
+		if g.Group == group {
+			return g, true
+		}
+	}
+	return GroupLag{}, false
+}
+
+// sample is the time the record at offset was produced.
+type sample struct {
+	offset kafka.Offset
+	time   time.Time
+}
+
+// history is the sampled head records of a partition, in increasing
+// offset order.
+type history struct {
+	samples []sample
+}
+
+// add adds a sample, replacing the most recent one if the partition's
+// head did not move, and keeps at most max samples.
+func (h *history) add(s sample, max int) {
+	n := len(h.samples)
+	if n > 0 && s.offset <= h.samples[n-1].offset {
+		if s.offset < h.samples[n-1].offset {
+			// Partition was truncated or recreated
+			h.samples = h.samples[:0]
+		} else {
+			// No new records: keep the time of the head record
+			return
+		}
+	}
+
+	h.samples = append(h.samples, s)
+	if len(h.samples) > max {
+		h.samples = append(h.samples[:0], h.samples[len(h.samples)-max:]...)
+	}
+}
+
+// producedAt returns the interpolated time the record at offset was
+// produced, or false if unknown.
+func (h *history) producedAt(offset kafka.Offset) (time.Time, bool) {
+	if len(h.samples) == 0 {
+		return time.Time{}, false
+	}
+
+	// First sample at or after offset
+	i := sort.Search(len(h.samples), func(i int) bool {
+		return h.samples[i].offset >= offset
+	})
+	if i == len(h.samples) {
+		return time.Time{}, false
+	}
+
+	after := h.samples[i]
+	if after.offset == offset || i == 0 {
+		// The record was produced no later than the first sample at or
+		// after it.
+		return after.time, true
+	}
+
+	before := h.samples[i-1]
+	ratio := float64(offset-before.offset) / float64(after.offset-before.offset)
+	return before.time.Add(time.Duration(ratio * float64(after.time.Sub(before.time)))), true
+}
@@ -0,0 +1,90 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lag
+
+import (
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistory(t *testing.T) {
+	assert := assert.New(t)
+
+	t0 := time.Unix(1700000000, 0)
+	h := &history{}
+
+	_, ok := h.producedAt(0)
+	assert.False(ok, "Empty history")
+
+	h.add(sample{offset: 99, time: t0}, 3)
+	h.add(sample{offset: 99, time: t0.Add(time.Minute)}, 3)
+	assert.Len(h.samples, 1, "Samples without new records should be ignored")
+
+	h.add(sample{offset: 199, time: t0.Add(10 * time.Second)}, 3)
+	h.add(sample{offset: 299, time: t0.Add(20 * time.Second)}, 3)
+
+	for _, tc := range []struct {
+		offset   kafka.Offset
+		expected time.Time
+	}{
+		{50, t0}, // Before the history: lower bound
+		{99, t0},
+		{149, t0.Add(5 * time.Second)},
+		{199, t0.Add(10 * time.Second)},
+		{274, t0.Add(17500 * time.Millisecond)},
+	} {
+		producedAt, ok := h.producedAt(tc.offset)
+		assert.True(ok)
+		assert.Equal(tc.expected, producedAt, "Offset %d", tc.offset)
+	}
+
+	_, ok = h.producedAt(300)
+	assert.False(ok, "Offsets after the head are not produced yet")
+
+	h.add(sample{offset: 399, time: t0.Add(30 * time.Second)}, 3)
+	assert.Len(h.samples, 3, "The history size should be bounded")
+	assert.Equal(kafka.Offset(199), h.samples[0].offset)
+
+	h.add(sample{offset: 9, time: t0.Add(40 * time.Second)}, 3)
+	assert.Len(h.samples, 1, "The history should be reset on truncation")
+}
+
+func TestGroupLag(t *testing.T) {
+	assert := assert.New(t)
+
+	g := GroupLag{
+		Group: "group",
+		Partitions: []PartitionLag{
+			{Topic: "a", Partition: 0, Lag: 10, TimeLag: time.Second},
+			{Topic: "a", Partition: 1, Lag: 5, TimeLag: time.Minute},
+			{Topic: "b", Partition: 0, Lag: -1, TimeLag: -1},
+		},
+	}
+
+	assert.Equal(map[string]int64{"a": 15, "b": 0}, g.TopicLag())
+	assert.Equal(time.Minute, g.MaxTimeLag())
+
+	s := &Snapshot{Groups: []GroupLag{g}}
+	found, ok := s.Group("group")
+	assert.True(ok)
+	assert.Equal(g, found)
+	_, ok = s.Group("other")
+	assert.False(ok)
+}
@@ -0,0 +1,404 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lag
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// Config holds the Monitor configuration.
+type Config struct {
+	// Groups are the consumer groups to monitor.
+	// If empty, all consumer groups of the cluster are listed and
+	// monitored.
+	Groups []string
+	// Interval between two snapshots (default 30s).
+	Interval time.Duration
+	// Concurrency is the maximum number of groups whose offsets are
+	// fetched concurrently (default 4).
+	Concurrency int
+	// RequestTimeout is the timeout of each admin request (default 30s).
+	RequestTimeout time.Duration
+	// HeadTimestamps uses the timestamps of the partitions' head records,
+	// rather than the time they were sampled, to compute time lag.
+	// Requires brokers supporting MaxTimestampOffsetSpec (Apache Kafka 3.0+).
+	HeadTimestamps bool
+	// HistorySize is the number of head samples kept per partition to
+	// interpolate time lag (default 120).
+	// With the default interval this covers an hour of lag.
+	HistorySize int
+	// SnapshotChannelSize is the size of the Snapshots() channel
+	// (default 1). The oldest snapshot is dropped when the channel is full.
+	SnapshotChannelSize int
+	// ErrorCb is called with the errors of periodic snapshots, if set.
+	ErrorCb func(err error)
+}
+
+// partitionKey identifies a partition
+type partitionKey struct {
+	topic     string
+	partition int32
+}
+
+// Monitor computes the lag of consumer groups.
+type Monitor struct {
+	a      *kafka.AdminClient
+	config Config
+
+	snapshots chan *Snapshot
+
+	lock   sync.Mutex
+	latest *Snapshot
+
+	// computeLock serializes snapshots, which update histories
+	computeLock sync.Mutex
+	histories   map[partitionKey]*history
+}
+
+// NewMonitor creates a new Monitor of the consumer groups of the cluster
+// of AdminClient a.
+func NewMonitor(a *kafka.AdminClient, config Config) (*Monitor, error) {
+	if a == nil {
+		return nil, kafka.NewError(kafka.ErrInvalidArg, "AdminClient is required", false)
+	}
+
+	if config.Interval < 0 || config.Concurrency < 0 || config.RequestTimeout < 0 ||
+		config.HistorySize < 0 || config.SnapshotChannelSize < 0 {
+		return nil, kafka.NewError(kafka.ErrInvalidArg,
+			"Config values must not be negative", false)
+	}
+	if config.Interval == 0 {
+		config.Interval = 30 * time.Second
+	}
+	if config.Concurrency == 0 {
+		config.Concurrency = 4
+	}
+	if config.RequestTimeout == 0 {
+		config.RequestTimeout = 30 * time.Second
+	}
+	if config.HistorySize == 0 {
+		config.HistorySize = 120
+	}
+	if config.SnapshotChannelSize == 0 {
+		config.SnapshotChannelSize = 1
+	}
+
+	return &Monitor{
+		a:         a,
+		config:    config,
+		snapshots: make(chan *Snapshot, config.SnapshotChannelSize),
+		histories: make(map[partitionKey]*history),
+	}, nil
+}
+
+// Snapshots returns the channel of the snapshots computed by Run().
+func (m *Monitor) Snapshots() <-chan *Snapshot {
+	return m.snapshots
+}
+
+// Latest returns the latest computed snapshot, or nil if none.
+func (m *Monitor) Latest() *Snapshot {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.latest
+}
+
+// Run computes a snapshot every Config.Interval, sending it to
+// Snapshots(), until ctx is done.
+//
+// Returns ctx.Err().
+func (m *Monitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		snapshot, err := m.Snapshot(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if m.config.ErrorCb != nil {
+				m.config.ErrorCb(err)
+			}
+		} else {
+			m.publish(snapshot)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// publish sends snapshot to Snapshots(), dropping the oldest snapshot if
+// the channel is full.
+func (m *Monitor) publish(snapshot *Snapshot) {
+	for {
+		select {
+		case m.snapshots <- snapshot:
+			return
+		default:
+		}
+
+		select {
+		case <-m.snapshots:
+		default:
+		}
+	}
+}
+
+// Snapshot computes the current lag of the monitored groups.
+//
+// Returns an error if the groups or the latest offsets could not be
+// listed. The errors of individual groups and partitions are set in the
+// snapshot.
+func (m *Monitor) Snapshot(ctx context.Context) (*Snapshot, error) {
+	m.computeLock.Lock()
+	defer m.computeLock.Unlock()
+
+	groups := m.config.Groups
+	if len(groups) == 0 {
+		var err error
+		groups, err = m.listGroups(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	groupLags := m.listGroupOffsets(ctx, groups)
+
+	// The latest offsets of all partitions are listed at once.
+	partitions := make(map[partitionKey]bool)
+	for _, g := range groupLags {
+		for _, p := range g.Partitions {
+			partitions[partitionKey{p.Topic, p.Partition}] = true
+		}
+	}
+
+	latest, err := m.listOffsets(ctx, partitions, kafka.LatestOffsetSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	var heads map[partitionKey]kafka.ListOffsetsResultInfo
+	if m.config.HeadTimestamps {
+		heads, err = m.listOffsets(ctx, partitions, kafka.MaxTimestampOffsetSpec)
+		if err != nil {
+			// Fall back to the sampling time
+			heads = nil
+		}
+	}
+
+	now := time.Now()
+	m.sample(now, partitions, latest, heads)
+
+	for i := range groupLags {
+		for j := range groupLags[i].Partitions {
+			m.computeLag(now, &groupLags[i].Partitions[j], latest)
+		}
+	}
+
+	snapshot := &Snapshot{Time: now, Groups: groupLags}
+
+	m.lock.Lock()
+	m.latest = snapshot
+	m.lock.Unlock()
+
+	return snapshot, nil
+}
+
+// listGroups lists the consumer groups of the cluster.
+func (m *Monitor) listGroups(ctx context.Context) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.config.RequestTimeout)
+	defer cancel()
+
+	res, err := m.a.ListConsumerGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]string, len(res.Valid))
+	for i, g := range res.Valid {
+		groups[i] = g.GroupID
+	}
+	return groups, nil
+}
+
+// listGroupOffsets lists the committed offsets of groups, with at most
+// Config.Concurrency concurrent requests.
+func (m *Monitor) listGroupOffsets(ctx context.Context, groups []string) []GroupLag {
+	groupLags := make([]GroupLag, len(groups))
+	sem := make(chan struct{}, m.config.Concurrency)
+	var wg sync.WaitGroup
+
+	for i, group := range groups {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(g *GroupLag, group string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			*g = m.listGroupOffset(ctx, group)
+		}(&groupLags[i], group)
+	}
+	wg.Wait()
+
+	sort.Slice(groupLags, func(i, j int) bool {
+		return groupLags[i].Group < groupLags[j].Group
+	})
+
+	return groupLags
+}
+
+// listGroupOffset lists the committed offsets of group.
+func (m *Monitor) listGroupOffset(ctx context.Context, group string) GroupLag {
+	g := GroupLag{Group: group}
+
+	ctx, cancel := context.WithTimeout(ctx, m.config.RequestTimeout)
+	defer cancel()
+
+	res, err := m.a.ListConsumerGroupOffsets(ctx,
+		[]kafka.ConsumerGroupTopicPartitions{{Group: group}})
+	if err != nil {
+		g.Error = err
+		return g
+	}
+
+	for _, tp := range res.ConsumerGroupsTopicPartitions[0].Partitions {
+		g.Partitions = append(g.Partitions, PartitionLag{
+			Topic:           *tp.Topic,
+			Partition:       tp.Partition,
+			CommittedOffset: tp.Offset,
+			LatestOffset:    kafka.OffsetInvalid,
+			Lag:             -1,
+			TimeLag:         -1,
+			Error:           tp.Error,
+		})
+	}
+
+	sort.Slice(g.Partitions, func(i, j int) bool {
+		a, b := g.Partitions[i], g.Partitions[j]
+		return a.Topic < b.Topic || (a.Topic == b.Topic && a.Partition < b.Partition)
+	})
+
+	return g
+}
+
+// listOffsets lists the offsets matching spec of partitions.
+func (m *Monitor) listOffsets(ctx context.Context, partitions map[partitionKey]bool,
+	spec kafka.OffsetSpec) (map[partitionKey]kafka.ListOffsetsResultInfo, error) {
+	offsets := make(map[partitionKey]kafka.ListOffsetsResultInfo)
+	if len(partitions) == 0 {
+		return offsets, nil
+	}
+
+	specs := make(map[kafka.TopicPartition]kafka.OffsetSpec, len(partitions))
+	for key := range partitions {
+		topic := key.topic
+		specs[kafka.TopicPartition{Topic: &topic, Partition: key.partition}] = spec
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, m.config.RequestTimeout)
+	defer cancel()
+
+	res, err := m.a.ListOffsets(ctx, specs)
+	if err != nil {
+		return nil, err
+	}
+
+	for tp, info := range res.ResultInfos {
+		offsets[partitionKey{*tp.Topic, tp.Partition}] = info
+	}
+	return offsets, nil
+}
+
+// sample adds the head records of partitions to their history.
+func (m *Monitor) sample(now time.Time, partitions map[partitionKey]bool,
+	latest map[partitionKey]kafka.ListOffsetsResultInfo,
+	heads map[partitionKey]kafka.ListOffsetsResultInfo) {
+	for key := range partitions {
+		info, found := latest[key]
+		if !found || info.Error.Code() != kafka.ErrNoError || info.Offset <= 0 {
+			continue
+		}
+
+		h := m.histories[key]
+		if h == nil {
+			h = &history{}
+			m.histories[key] = h
+		}
+
+		// The head record is at the latest offset - 1
+		s := sample{offset: info.Offset - 1, time: now}
+		if head, found := heads[key]; found && head.Error.Code() == kafka.ErrNoError &&
+			head.Offset == s.offset && head.Timestamp >= 0 {
+			s.time = time.UnixMilli(head.Timestamp)
+		}
+		h.add(s, m.config.HistorySize)
+	}
+
+	// Forget the partitions that are no longer monitored
+	for key := range m.histories {
+		if !partitions[key] {
+			delete(m.histories, key)
+		}
+	}
+}
+
+// computeLag computes the lag of p from its latest offset and history.
+func (m *Monitor) computeLag(now time.Time, p *PartitionLag,
+	latest map[partitionKey]kafka.ListOffsetsResultInfo) {
+	key := partitionKey{p.Topic, p.Partition}
+
+	info, found := latest[key]
+	if !found {
+		return
+	}
+	if info.Error.Code() != kafka.ErrNoError {
+		if p.Error == nil {
+			p.Error = info.Error
+		}
+		return
+	}
+	p.LatestOffset = info.Offset
+
+	if p.Error != nil || p.CommittedOffset < 0 {
+		return
+	}
+
+	if p.CommittedOffset >= p.LatestOffset {
+		p.Lag = 0
+		p.TimeLag = 0
+		return
+	}
+	p.Lag = int64(p.LatestOffset - p.CommittedOffset)
+
+	if h := m.histories[key]; h != nil {
+		if producedAt, ok := h.producedAt(p.CommittedOffset); ok {
+			p.TimeLag = now.Sub(producedAt)
+			if p.TimeLag < 0 {
+				p.TimeLag = 0
+			}
+		}
+	}
+}
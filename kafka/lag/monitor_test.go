@@ -0,0 +1,139 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lag
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMonitor tests the offset and time lag of consumer groups
+// on a mock cluster.
+func TestMonitor(t *testing.T) {
+	assert := assert.New(t)
+
+	mockCluster, err := kafka.NewMockCluster(1)
+	assert.NoError(err, "Mock cluster creation should succeed")
+	defer mockCluster.Close()
+
+	topic := "orders"
+	assert.NoError(mockCluster.CreateTopic(topic, 2, 1))
+
+	p, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+	})
+	assert.NoError(err, "Producer creation should succeed")
+	defer p.Close()
+
+	produce := func(cnt int) {
+		for i := 0; i < cnt; i++ {
+			err := p.Produce(&kafka.Message{
+				TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: int32(i % 2)},
+				Value:          []byte("value"),
+			}, nil)
+			assert.NoError(err)
+		}
+		assert.Zero(p.Flush(10 * 1000))
+	}
+	produce(20)
+
+	// Commit offsets for two groups: "behind" on both partitions,
+	// "current" up to date.
+	for group, offset := range map[string]kafka.Offset{"behind": 4, "current": 10} {
+		c, err := kafka.NewConsumer(&kafka.ConfigMap{
+			"bootstrap.servers": mockCluster.BootstrapServers(),
+			"group.id":          group,
+		})
+		assert.NoError(err, "Consumer creation should succeed")
+		_, err = c.CommitOffsets([]kafka.TopicPartition{
+			{Topic: &topic, Partition: 0, Offset: offset},
+			{Topic: &topic, Partition: 1, Offset: offset},
+		})
+		assert.NoError(err, "Commit should succeed")
+		c.Close()
+	}
+
+	a, err := kafka.NewAdminClient(&kafka.ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+	})
+	assert.NoError(err, "AdminClient creation should succeed")
+	defer a.Close()
+
+	_, err = NewMonitor(nil, Config{})
+	assert.Error(err, "An AdminClient should be required")
+
+	m, err := NewMonitor(a, Config{
+		Groups:      []string{"current", "behind"},
+		Interval:    100 * time.Millisecond,
+		Concurrency: 1,
+	})
+	assert.NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	first, err := m.Snapshot(ctx)
+	assert.NoError(err)
+	assert.Equal(first, m.Latest())
+	if !assert.Len(first.Groups, 2) {
+		return
+	}
+	assert.Equal("behind", first.Groups[0].Group, "Groups should be ordered")
+
+	behind, _ := first.Group("behind")
+	assert.NoError(behind.Error)
+	assert.Equal(map[string]int64{topic: 12}, behind.TopicLag())
+	for i, p := range behind.Partitions {
+		assert.Equal(int32(i), p.Partition)
+		assert.Equal(kafka.Offset(4), p.CommittedOffset)
+		assert.Equal(kafka.Offset(10), p.LatestOffset)
+		assert.Equal(int64(6), p.Lag)
+		assert.GreaterOrEqual(p.TimeLag, time.Duration(0))
+	}
+
+	current, _ := first.Group("current")
+	assert.Equal(map[string]int64{topic: 0}, current.TopicLag())
+	assert.Zero(current.MaxTimeLag())
+
+	// The lag of the next snapshots grows with the age of the
+	// unconsumed records.
+	time.Sleep(200 * time.Millisecond)
+	produce(2)
+
+	go m.Run(ctx)
+
+	var next *Snapshot
+	select {
+	case next = <-m.Snapshots():
+	case <-ctx.Done():
+		t.Fatalf("Expected snapshot")
+	}
+
+	behind, _ = next.Group("behind")
+	assert.Equal(map[string]int64{topic: 14}, behind.TopicLag())
+	assert.GreaterOrEqual(behind.MaxTimeLag(), 200*time.Millisecond,
+		"Time lag should be interpolated from the first snapshot")
+
+	current, _ = next.Group("current")
+	assert.Equal(map[string]int64{topic: 2}, current.TopicLag())
+	assert.Less(current.MaxTimeLag(), 200*time.Millisecond,
+		"Time lag of new records should be recent")
+}
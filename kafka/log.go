@@ -1,7 +1,10 @@
 package kafka
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
+	"strings"
 	"time"
 )
 
@@ -42,12 +45,12 @@ func (h *handle) newLogEvent(cEvent *C.rd_kafka_event_t) LogEvent {
 }
 
 // pollLogEvents polls log events from librdkafka and pushes them to toChannel,
-// until doneChan is closed.
+// or logs them to logger if set, until doneChan is closed.
 //
 // Each call to librdkafka times out after timeoutMs. If a call to librdkafka
 // is ongoing when doneChan is closed, the function will wait until the call
 // returns or times out, whatever happens first.
-func (h *handle) pollLogEvents(toChannel chan LogEvent, timeoutMs int, doneChan chan bool) {
+func (h *handle) pollLogEvents(toChannel chan LogEvent, logger *slog.Logger, timeoutMs int, doneChan chan bool) {
 	for {
 		select {
 		case <-doneChan:
@@ -67,6 +70,13 @@ func (h *handle) pollLogEvents(toChannel chan LogEvent, timeoutMs int, doneChan
 			logEvent := h.newLogEvent(cEvent)
 			C.rd_kafka_event_destroy(cEvent)
 
+			if logger != nil {
+				// Logged synchronously: librdkafka's log queue buffers
+				// events while the logger is busy, none are dropped.
+				logEvent.LogTo(logger)
+				continue
+			}
+
 			select {
 			case <-doneChan:
 				return
@@ -87,3 +97,76 @@ func (logEvent LogEvent) String() string {
 		logEvent.Level,
 		logEvent.Message)
 }
+
+// SlogLevel returns the slog.Level of the log's syslog Level.
+func (logEvent LogEvent) SlogLevel() slog.Level {
+	switch {
+	case logEvent.Level <= 3: // LOG_EMERG, LOG_ALERT, LOG_CRIT, LOG_ERR
+		return slog.LevelError
+	case logEvent.Level == 4: // LOG_WARNING
+		return slog.LevelWarn
+	case logEvent.Level <= 6: // LOG_NOTICE, LOG_INFO
+		return slog.LevelInfo
+	default: // LOG_DEBUG
+		return slog.LevelDebug
+	}
+}
+
+// parseLogMessage splits a librdkafka log message of the form
+// "[thrd:<thread>]: [<broker>: ]<message>" into the name of the logging
+// thread, the broker the log relates to, if any, and the message.
+func parseLogMessage(logMessage string) (thread string, broker string, message string) {
+	message = logMessage
+	if !strings.HasPrefix(message, "[thrd:") {
+		return "", "", message
+	}
+
+	end := strings.Index(message, "]: ")
+	if end == -1 {
+		return "", "", message
+	}
+	thread = message[len("[thrd:"):end]
+	message = message[end+len("]: "):]
+
+	// Broker threads are named after their broker, e.g.,
+	// "sasl_ssl://localhost:9092/1" or "GroupCoordinator".
+	if strings.Contains(thread, "://") || thread == "GroupCoordinator" {
+		broker = thread
+		message = strings.TrimPrefix(message, broker+": ")
+	}
+
+	return thread, broker, message
+}
+
+// LogTo logs the log event to logger, if enabled for its level,
+// with the structured attributes:
+//
+//	name - name of the client instance
+//	tag - log tag (librdkafka facility), e.g., "METADATA"
+//	syslog_level - log syslog level
+//	thread - librdkafka thread that logged the event, if known
+//	broker - broker the event relates to, if any
+func (logEvent LogEvent) LogTo(logger *slog.Logger) {
+	ctx := context.Background()
+	level := logEvent.SlogLevel()
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	thread, broker, message := parseLogMessage(logEvent.Message)
+
+	record := slog.NewRecord(logEvent.Timestamp, level, message, 0)
+	record.AddAttrs(
+		slog.String("name", logEvent.Name),
+		slog.String("tag", logEvent.Tag),
+		slog.Int("syslog_level", logEvent.Level))
+	if thread != "" {
+		record.AddAttrs(slog.String("thread", thread))
+	}
+	if broker != "" {
+		record.AddAttrs(slog.String("broker", broker))
+	}
+
+	// Handler errors are ignored, as with slog.Logger.Log()
+	_ = logger.Handler().Handle(ctx, record)
+}
@@ -0,0 +1,133 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kafka
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestParseLogMessage tests the parsing of librdkafka log messages
+func TestParseLogMessage(t *testing.T) {
+	for _, tc := range []struct {
+		logMessage string
+		thread     string
+		broker     string
+		message    string
+	}{
+		{"[thrd:app]: librdkafka v2.10.0 initialized", "app", "", "librdkafka v2.10.0 initialized"},
+		{"[thrd:sasl_ssl://localhost:9092/1]: sasl_ssl://localhost:9092/1: Connect to ipv4#127.0.0.1:9092 failed",
+			"sasl_ssl://localhost:9092/1", "sasl_ssl://localhost:9092/1", "Connect to ipv4#127.0.0.1:9092 failed"},
+		{"[thrd:GroupCoordinator]: GroupCoordinator: Disconnected", "GroupCoordinator", "GroupCoordinator", "Disconnected"},
+		{"no thread", "", "", "no thread"},
+		{"[thrd:main unterminated", "", "", "[thrd:main unterminated"},
+	} {
+		thread, broker, message := parseLogMessage(tc.logMessage)
+		if thread != tc.thread || broker != tc.broker || message != tc.message {
+			t.Errorf("parseLogMessage(%q): expected (%q, %q, %q), got (%q, %q, %q)",
+				tc.logMessage, tc.thread, tc.broker, tc.message, thread, broker, message)
+		}
+	}
+}
+
+// TestLogEventLogTo tests logging LogEvents to a slog.Logger
+func TestLogEventLogTo(t *testing.T) {
+	for level, expected := range map[int]slog.Level{
+		0: slog.LevelError, 3: slog.LevelError, 4: slog.LevelWarn,
+		5: slog.LevelInfo, 6: slog.LevelInfo, 7: slog.LevelDebug,
+	} {
+		if actual := (LogEvent{Level: level}).SlogLevel(); actual != expected {
+			t.Errorf("Syslog level %d: expected %v, got %v", level, expected, actual)
+		}
+	}
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	LogEvent{
+		Name:      "rdkafka#producer-1",
+		Tag:       "FAIL",
+		Message:   "[thrd:localhost:9092/bootstrap]: localhost:9092/bootstrap: Connect failed",
+		Level:     3,
+		Timestamp: time.Now(),
+	}.LogTo(logger)
+	// Filtered out by the logger's level
+	LogEvent{Name: "rdkafka#producer-1", Tag: "BROKER", Message: "debug", Level: 7}.LogTo(logger)
+
+	var record map[string]interface{}
+	err := json.Unmarshal(buf.Bytes(), &record)
+	if err != nil {
+		t.Fatalf("Expected a single JSON record, got %q: %s", buf.String(), err)
+	}
+
+	for key, value := range map[string]interface{}{
+		"level":        "ERROR",
+		"msg":          "localhost:9092/bootstrap: Connect failed",
+		"name":         "rdkafka#producer-1",
+		"tag":          "FAIL",
+		"syslog_level": float64(3),
+		"thread":       "localhost:9092/bootstrap",
+	} {
+		if record[key] != value {
+			t.Errorf("Expected %s=%v, got %v", key, value, record[key])
+		}
+	}
+}
+
+// TestProducerSlog dry-tests go.logs.slog, no broker is needed.
+func TestProducerSlog(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	p, err := NewProducer(&ConfigMap{
+		"debug":             "all",
+		"go.logs.slog":      logger,
+		"socket.timeout.ms": 10})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	if p.Logs() != nil {
+		t.Errorf("Logs() should not be enabled with go.logs.slog")
+	}
+
+	time.Sleep(500 * time.Millisecond)
+	// Close() waits for the log events to be logged.
+	p.Close()
+
+	// The logs emitted while the producer was created are logged.
+	if !strings.Contains(buf.String(), "tag=INIT") ||
+		!strings.Contains(buf.String(), "name=rdkafka#producer-") {
+		t.Errorf("Expected INIT log record, got:\n%s", buf.String())
+	}
+
+	_, err = NewProducer(&ConfigMap{"go.logs.slog": "logger"})
+	if err == nil || err.(Error).Code() != ErrInvalidArg {
+		t.Errorf("Expected ErrInvalidArg for invalid go.logs.slog, got %v", err)
+	}
+
+	_, err = NewProducer(&ConfigMap{
+		"go.logs.slog":           logger,
+		"go.logs.channel.enable": true})
+	if err == nil || err.(Error).Code() != ErrInvalidArg {
+		t.Errorf("Expected ErrInvalidArg for go.logs.slog with go.logs.channel.enable, got %v", err)
+	}
+}
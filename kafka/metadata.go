@@ -17,6 +17,7 @@
 package kafka
 
 import (
+	"context"
 	"unsafe"
 )
 
@@ -79,6 +80,28 @@ type Metadata struct {
 // allTopics is false only information about locally used topics is returned,
 // else information about all topics is returned.
 func getMetadata(H Handle, topic *string, allTopics bool, timeoutMs int) (*Metadata, error) {
+	return getMetadata0(H, topic, allTopics, C.int(timeoutMs))
+}
+
+// getMetadataContext queries broker for cluster and topic metadata,
+// returning ctx.Err() as soon as ctx is done.
+func getMetadataContext(ctx context.Context, H Handle, topic *string, allTopics bool) (*Metadata, error) {
+	var m *Metadata
+	err := H.gethandle().callContext(ctx, func(cTimeoutMs C.int) error {
+		var cerr error
+		m, cerr = getMetadata0(H, topic, allTopics, cTimeoutMs)
+		return cerr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// getMetadata0 queries broker for cluster and topic metadata,
+// blocking for at most cTimeoutMs.
+func getMetadata0(H Handle, topic *string, allTopics bool, cTimeoutMs C.int) (*Metadata, error) {
 	h := H.gethandle()
 
 	var rkt *C.rd_kafka_topic_t
@@ -88,7 +111,7 @@ func getMetadata(H Handle, topic *string, allTopics bool, timeoutMs int) (*Metad
 
 	var cMd *C.struct_rd_kafka_metadata
 	cErr := C.rd_kafka_metadata(h.rk, bool2cint(allTopics),
-		rkt, &cMd, C.int(timeoutMs))
+		rkt, &cMd, cTimeoutMs)
 	if cErr != C.RD_KAFKA_RESP_ERR_NO_ERROR {
 		return nil, newError(cErr)
 	}
@@ -139,6 +162,28 @@ func getMetadata(H Handle, topic *string, allTopics bool, timeoutMs int) (*Metad
 // queryWatermarkOffsets returns the broker's low and high offsets for the given topic
 // and partition.
 func queryWatermarkOffsets(H Handle, topic string, partition int32, timeoutMs int) (low, high int64, err error) {
+	return queryWatermarkOffsets0(H, topic, partition, C.int(timeoutMs))
+}
+
+// queryWatermarkOffsetsContext returns the broker's low and high offsets for
+// the given topic and partition, returning ctx.Err() as soon as ctx is done.
+func queryWatermarkOffsetsContext(ctx context.Context, H Handle, topic string, partition int32) (low, high int64, err error) {
+	var wmLow, wmHigh int64
+	err = H.gethandle().callContext(ctx, func(cTimeoutMs C.int) error {
+		var cerr error
+		wmLow, wmHigh, cerr = queryWatermarkOffsets0(H, topic, partition, cTimeoutMs)
+		return cerr
+	})
+	if err != nil {
+		return -1, -1, err
+	}
+
+	return wmLow, wmHigh, nil
+}
+
+// queryWatermarkOffsets0 returns the broker's low and high offsets for the
+// given topic and partition, blocking for at most cTimeoutMs.
+func queryWatermarkOffsets0(H Handle, topic string, partition int32, cTimeoutMs C.int) (low, high int64, err error) {
 	h := H.gethandle()
 
 	ctopic := C.CString(topic)
@@ -147,7 +192,7 @@ func queryWatermarkOffsets(H Handle, topic string, partition int32, timeoutMs in
 	var cLow, cHigh C.int64_t
 
 	e := C.rd_kafka_query_watermark_offsets(h.rk, ctopic, C.int32_t(partition),
-		&cLow, &cHigh, C.int(timeoutMs))
+		&cLow, &cHigh, cTimeoutMs)
 	if e != C.RD_KAFKA_RESP_ERR_NO_ERROR {
 		return 0, 0, newError(e)
 	}
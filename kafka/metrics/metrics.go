@@ -0,0 +1,428 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package metrics exports the librdkafka statistics of Producer and
+// Consumer instances as Prometheus/OpenMetrics metrics, or through expvar.
+//
+// Statistics are emitted by the clients every `statistics.interval.ms`.
+// The Exporter is hooked up through the `go.stats.cb` configuration
+// property, which leaves the Stats events to the application:
+//
+//	exporter := metrics.NewExporter()
+//	prometheus.MustRegister(exporter)
+//
+//	c, err := kafka.NewConsumer(&kafka.ConfigMap{
+//		"bootstrap.servers":      "localhost:9092",
+//		"group.id":               "mygroup",
+//		"statistics.interval.ms": 5000,
+//		"go.stats.cb":            exporter.StatsCb(),
+//	})
+//
+// Alternatively, Stats events consumed by the application may be passed
+// to Exporter.Update().
+//
+// `go.stats.cb` is a producer and consumer property: AdminClient instances
+// do not serve Stats events.
+package metrics
+
+import (
+	"encoding/json"
+	"expvar"
+	"strconv"
+	"sync"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "kafka"
+
+// Label names
+var (
+	clientLabels    = []string{"client_id", "name", "type"}
+	brokerLabels    = withLabels(clientLabels, "broker", "nodeid")
+	topicLabels     = withLabels(clientLabels, "topic")
+	partitionLabels = withLabels(topicLabels, "partition")
+	windowLabels    = withLabels(brokerLabels, "quantile")
+)
+
+// withLabels returns a copy of labels with the extra labels appended.
+func withLabels(labels []string, extra ...string) []string {
+	return append(append(make([]string, 0, len(labels)+len(extra)), labels...), extra...)
+}
+
+// clientMetric is a metric of the client instance.
+type clientMetric struct {
+	desc      *prometheus.Desc
+	valueType prometheus.ValueType
+	value     func(s *kafka.Statistics) float64
+}
+
+// brokerMetric is a metric of a broker connection.
+type brokerMetric struct {
+	desc      *prometheus.Desc
+	valueType prometheus.ValueType
+	value     func(b *kafka.BrokerStats) float64
+}
+
+// windowMetric is a latency metric of a broker connection, exported as
+// one gauge per quantile.
+type windowMetric struct {
+	desc *prometheus.Desc
+	// scale converts the window's unit to seconds
+	scale  float64
+	window func(b *kafka.BrokerStats) *kafka.WindowStats
+}
+
+// partitionMetric is a metric of a topic partition.
+type partitionMetric struct {
+	desc      *prometheus.Desc
+	valueType prometheus.ValueType
+	value     func(p *kafka.PartitionStats) float64
+}
+
+func newDesc(subsystem, name, help string, labels []string) *prometheus.Desc {
+	return prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, name),
+		help, labels, nil)
+}
+
+var clientMetrics = []clientMetric{
+	{newDesc("client", "replyq", "Number of ops waiting in queue for the application to serve.", clientLabels),
+		prometheus.GaugeValue, func(s *kafka.Statistics) float64 { return float64(s.ReplyQ) }},
+	{newDesc("client", "msg_cnt", "Current number of messages in producer queues.", clientLabels),
+		prometheus.GaugeValue, func(s *kafka.Statistics) float64 { return float64(s.MsgCnt) }},
+	{newDesc("client", "msg_size_bytes", "Current total size of messages in producer queues.", clientLabels),
+		prometheus.GaugeValue, func(s *kafka.Statistics) float64 { return float64(s.MsgSize) }},
+	{newDesc("client", "tx_requests_total", "Total number of requests sent to brokers.", clientLabels),
+		prometheus.CounterValue, func(s *kafka.Statistics) float64 { return float64(s.Tx) }},
+	{newDesc("client", "tx_bytes_total", "Total number of bytes transmitted to brokers.", clientLabels),
+		prometheus.CounterValue, func(s *kafka.Statistics) float64 { return float64(s.TxBytes) }},
+	{newDesc("client", "rx_responses_total", "Total number of responses received from brokers.", clientLabels),
+		prometheus.CounterValue, func(s *kafka.Statistics) float64 { return float64(s.Rx) }},
+	{newDesc("client", "rx_bytes_total", "Total number of bytes received from brokers.", clientLabels),
+		prometheus.CounterValue, func(s *kafka.Statistics) float64 { return float64(s.RxBytes) }},
+	{newDesc("client", "tx_messages_total", "Total number of messages produced.", clientLabels),
+		prometheus.CounterValue, func(s *kafka.Statistics) float64 { return float64(s.TxMsgs) }},
+	{newDesc("client", "tx_message_bytes_total", "Total number of message bytes produced.", clientLabels),
+		prometheus.CounterValue, func(s *kafka.Statistics) float64 { return float64(s.TxMsgBytes) }},
+	{newDesc("client", "rx_messages_total", "Total number of messages consumed.", clientLabels),
+		prometheus.CounterValue, func(s *kafka.Statistics) float64 { return float64(s.RxMsgs) }},
+	{newDesc("client", "rx_message_bytes_total", "Total number of message bytes consumed.", clientLabels),
+		prometheus.CounterValue, func(s *kafka.Statistics) float64 { return float64(s.RxMsgBytes) }},
+}
+
+// cgrpMetrics are only exported for consumers with a group.
+var cgrpMetrics = []clientMetric{
+	{newDesc("consumer_group", "rebalances_total", "Total number of rebalances (assign or revoke).", clientLabels),
+		prometheus.CounterValue, func(s *kafka.Statistics) float64 { return float64(s.Cgrp.RebalanceCnt) }},
+	{newDesc("consumer_group", "assignment_size", "Current assignment's partition count.", clientLabels),
+		prometheus.GaugeValue, func(s *kafka.Statistics) float64 { return float64(s.Cgrp.AssignmentSize) }},
+	{newDesc("consumer_group", "rebalance_age_seconds", "Time elapsed since last rebalance.", clientLabels),
+		prometheus.GaugeValue, func(s *kafka.Statistics) float64 { return float64(s.Cgrp.RebalanceAge) / 1e3 }},
+}
+
+var brokerMetrics = []brokerMetric{
+	{newDesc("broker", "outbuf_requests", "Number of requests awaiting transmission to broker.", brokerLabels),
+		prometheus.GaugeValue, func(b *kafka.BrokerStats) float64 { return float64(b.OutbufCnt) }},
+	{newDesc("broker", "outbuf_messages", "Number of messages awaiting transmission to broker.", brokerLabels),
+		prometheus.GaugeValue, func(b *kafka.BrokerStats) float64 { return float64(b.OutbufMsgCnt) }},
+	{newDesc("broker", "waitresp_requests", "Number of requests in-flight to broker awaiting response.", brokerLabels),
+		prometheus.GaugeValue, func(b *kafka.BrokerStats) float64 { return float64(b.WaitrespCnt) }},
+	{newDesc("broker", "waitresp_messages", "Number of messages in-flight to broker awaiting response.", brokerLabels),
+		prometheus.GaugeValue, func(b *kafka.BrokerStats) float64 { return float64(b.WaitrespMsgCnt) }},
+	{newDesc("broker", "tx_requests_total", "Total number of requests sent.", brokerLabels),
+		prometheus.CounterValue, func(b *kafka.BrokerStats) float64 { return float64(b.Tx) }},
+	{newDesc("broker", "tx_bytes_total", "Total number of bytes sent.", brokerLabels),
+		prometheus.CounterValue, func(b *kafka.BrokerStats) float64 { return float64(b.TxBytes) }},
+	{newDesc("broker", "tx_errors_total", "Total number of transmission errors.", brokerLabels),
+		prometheus.CounterValue, func(b *kafka.BrokerStats) float64 { return float64(b.TxErrs) }},
+	{newDesc("broker", "tx_retries_total", "Total number of request retries.", brokerLabels),
+		prometheus.CounterValue, func(b *kafka.BrokerStats) float64 { return float64(b.TxRetries) }},
+	{newDesc("broker", "request_timeouts_total", "Total number of requests timed out.", brokerLabels),
+		prometheus.CounterValue, func(b *kafka.BrokerStats) float64 { return float64(b.ReqTimeouts) }},
+	{newDesc("broker", "rx_responses_total", "Total number of responses received.", brokerLabels),
+		prometheus.CounterValue, func(b *kafka.BrokerStats) float64 { return float64(b.Rx) }},
+	{newDesc("broker", "rx_bytes_total", "Total number of bytes received.", brokerLabels),
+		prometheus.CounterValue, func(b *kafka.BrokerStats) float64 { return float64(b.RxBytes) }},
+	{newDesc("broker", "rx_errors_total", "Total number of receive errors.", brokerLabels),
+		prometheus.CounterValue, func(b *kafka.BrokerStats) float64 { return float64(b.RxErrs) }},
+	{newDesc("broker", "connects_total", "Number of connection attempts.", brokerLabels),
+		prometheus.CounterValue, func(b *kafka.BrokerStats) float64 { return float64(b.Connects) }},
+	{newDesc("broker", "disconnects_total", "Number of disconnects.", brokerLabels),
+		prometheus.CounterValue, func(b *kafka.BrokerStats) float64 { return float64(b.Disconnects) }},
+}
+
+var windowMetrics = []windowMetric{
+	{newDesc("broker", "rtt_seconds", "Broker latency / round-trip time.", windowLabels),
+		1e-6, func(b *kafka.BrokerStats) *kafka.WindowStats { return &b.Rtt }},
+	{newDesc("broker", "int_latency_seconds", "Internal producer queue latency.", windowLabels),
+		1e-6, func(b *kafka.BrokerStats) *kafka.WindowStats { return &b.IntLatency }},
+	{newDesc("broker", "outbuf_latency_seconds", "Internal request queue latency.", windowLabels),
+		1e-6, func(b *kafka.BrokerStats) *kafka.WindowStats { return &b.OutbufLatency }},
+	{newDesc("broker", "throttle_seconds", "Broker throttling time.", windowLabels),
+		1e-3, func(b *kafka.BrokerStats) *kafka.WindowStats { return &b.Throttle }},
+}
+
+// windowQuantiles are the exported quantiles of windowMetrics
+var windowQuantiles = []struct {
+	quantile string
+	value    func(w *kafka.WindowStats) int64
+}{
+	{"0.5", func(w *kafka.WindowStats) int64 { return w.P50 }},
+	{"0.9", func(w *kafka.WindowStats) int64 { return w.P90 }},
+	{"0.95", func(w *kafka.WindowStats) int64 { return w.P95 }},
+	{"0.99", func(w *kafka.WindowStats) int64 { return w.P99 }},
+	{"0.9999", func(w *kafka.WindowStats) int64 { return w.P9999 }},
+}
+
+var partitionMetrics = []partitionMetric{
+	{newDesc("partition", "msgq_messages", "Number of messages waiting to be produced in first-level queue.", partitionLabels),
+		prometheus.GaugeValue, func(p *kafka.PartitionStats) float64 { return float64(p.MsgqCnt) }},
+	{newDesc("partition", "xmit_msgq_messages", "Number of messages ready to be produced in transmit queue.", partitionLabels),
+		prometheus.GaugeValue, func(p *kafka.PartitionStats) float64 { return float64(p.XmitMsgqCnt) }},
+	{newDesc("partition", "fetchq_messages", "Number of pre-fetched messages in fetch queue.", partitionLabels),
+		prometheus.GaugeValue, func(p *kafka.PartitionStats) float64 { return float64(p.FetchqCnt) }},
+	{newDesc("partition", "fetchq_bytes", "Bytes of pre-fetched messages in fetch queue.", partitionLabels),
+		prometheus.GaugeValue, func(p *kafka.PartitionStats) float64 { return float64(p.FetchqSize) }},
+	{newDesc("partition", "hi_offset", "Partition's high watermark offset on broker.", partitionLabels),
+		prometheus.GaugeValue, func(p *kafka.PartitionStats) float64 { return float64(p.HiOffset) }},
+	{newDesc("partition", "committed_offset", "Last committed offset.", partitionLabels),
+		prometheus.GaugeValue, func(p *kafka.PartitionStats) float64 { return float64(p.CommittedOffset) }},
+	{newDesc("partition", "consumer_lag", "Difference between the high watermark (or last stable) offset and the committed offset.", partitionLabels),
+		prometheus.GaugeValue, func(p *kafka.PartitionStats) float64 { return float64(p.ConsumerLag) }},
+	{newDesc("partition", "consumer_lag_stored", "Difference between the high watermark (or last stable) offset and the stored offset.", partitionLabels),
+		prometheus.GaugeValue, func(p *kafka.PartitionStats) float64 { return float64(p.ConsumerLagStored) }},
+	{newDesc("partition", "tx_messages_total", "Total number of messages produced.", partitionLabels),
+		prometheus.CounterValue, func(p *kafka.PartitionStats) float64 { return float64(p.TxMsgs) }},
+	{newDesc("partition", "tx_bytes_total", "Total number of bytes produced.", partitionLabels),
+		prometheus.CounterValue, func(p *kafka.PartitionStats) float64 { return float64(p.TxBytes) }},
+	{newDesc("partition", "rx_messages_total", "Total number of messages consumed.", partitionLabels),
+		prometheus.CounterValue, func(p *kafka.PartitionStats) float64 { return float64(p.RxMsgs) }},
+	{newDesc("partition", "rx_bytes_total", "Total number of bytes consumed.", partitionLabels),
+		prometheus.CounterValue, func(p *kafka.PartitionStats) float64 { return float64(p.RxBytes) }},
+}
+
+var topicConsumerLagDesc = newDesc("topic", "consumer_lag",
+	"Total consumer lag of the topic's partitions.", topicLabels)
+
+var statsParseErrorsDesc = newDesc("stats", "parse_errors_total",
+	"Total number of statistics that failed to parse.", clientLabels)
+
+// statsClient identifies the client that emitted statistics that failed to
+// parse, by the top-level fields that could be decoded.
+type statsClient struct {
+	ClientID string `json:"client_id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+}
+
+// Exporter collects the latest statistics of each client instance and
+// exports them as Prometheus metrics.
+//
+// Exporter implements prometheus.Collector.
+type Exporter struct {
+	lock  sync.Mutex
+	stats map[string]*kafka.Statistics
+	// parseErrors is the number of statistics that failed to parse, per
+	// client
+	parseErrors map[statsClient]int64
+}
+
+// NewExporter creates a new Exporter.
+func NewExporter() *Exporter {
+	return &Exporter{
+		stats:       make(map[string]*kafka.Statistics),
+		parseErrors: make(map[statsClient]int64),
+	}
+}
+
+// StatsCb returns the callback to set as the `go.stats.cb` configuration
+// property of the clients to export.
+func (e *Exporter) StatsCb() kafka.StatsCb {
+	return e.Update
+}
+
+// Update updates the exported metrics of the client that emitted stats.
+func (e *Exporter) Update(stats *kafka.Stats) {
+	s, err := stats.Parse()
+	if err != nil {
+		// Decoding errors are ignored: the client labels are then empty.
+		var client statsClient
+		_ = json.Unmarshal([]byte(stats.String()), &client)
+
+		e.lock.Lock()
+		e.parseErrors[client]++
+		e.lock.Unlock()
+		return
+	}
+	e.UpdateStatistics(s)
+}
+
+// UpdateStatistics updates the exported metrics of the client described
+// by s.
+func (e *Exporter) UpdateStatistics(s *kafka.Statistics) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.stats[s.Name] = s
+}
+
+// Remove stops exporting the metrics of the client instance name,
+// e.g., once it is closed.
+func (e *Exporter) Remove(name string) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	delete(e.stats, name)
+	for client := range e.parseErrors {
+		if client.Name == name {
+			delete(e.parseErrors, client)
+		}
+	}
+}
+
+// snapshot returns the latest statistics of each client, and the number
+// of statistics that failed to parse per client.
+func (e *Exporter) snapshot() ([]*kafka.Statistics, map[statsClient]int64) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	all := make([]*kafka.Statistics, 0, len(e.stats))
+	for _, s := range e.stats {
+		all = append(all, s)
+	}
+	parseErrors := make(map[statsClient]int64, len(e.parseErrors))
+	for client, cnt := range e.parseErrors {
+		parseErrors[client] = cnt
+	}
+	return all, parseErrors
+}
+
+// Describe implements prometheus.Collector.
+func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {
+	for _, m := range clientMetrics {
+		ch <- m.desc
+	}
+	for _, m := range cgrpMetrics {
+		ch <- m.desc
+	}
+	for _, m := range brokerMetrics {
+		ch <- m.desc
+	}
+	for _, m := range windowMetrics {
+		ch <- m.desc
+	}
+	for _, m := range partitionMetrics {
+		ch <- m.desc
+	}
+	ch <- topicConsumerLagDesc
+	ch <- statsParseErrorsDesc
+}
+
+// Collect implements prometheus.Collector.
+func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
+	all, parseErrors := e.snapshot()
+
+	for client, cnt := range parseErrors {
+		ch <- prometheus.MustNewConstMetric(statsParseErrorsDesc,
+			prometheus.CounterValue, float64(cnt), client.ClientID, client.Name, client.Type)
+	}
+
+	for _, s := range all {
+		client := []string{s.ClientID, s.Name, s.Type}
+
+		for _, m := range clientMetrics {
+			ch <- prometheus.MustNewConstMetric(m.desc, m.valueType, m.value(s), client...)
+		}
+
+		if s.Cgrp != nil {
+			for _, m := range cgrpMetrics {
+				ch <- prometheus.MustNewConstMetric(m.desc, m.valueType, m.value(s), client...)
+			}
+		}
+
+		for _, b := range s.Brokers {
+			b := b
+			broker := withLabels(client, b.Name, strconv.Itoa(int(b.NodeID)))
+
+			for _, m := range brokerMetrics {
+				ch <- prometheus.MustNewConstMetric(m.desc, m.valueType, m.value(&b), broker...)
+			}
+
+			for _, m := range windowMetrics {
+				w := m.window(&b)
+				if w.Cnt == 0 {
+					continue
+				}
+				for _, q := range windowQuantiles {
+					ch <- prometheus.MustNewConstMetric(m.desc, prometheus.GaugeValue,
+						float64(q.value(w))*m.scale,
+						withLabels(broker, q.quantile)...)
+				}
+			}
+		}
+
+		for _, t := range s.Topics {
+			topic := withLabels(client, t.Topic)
+
+			if s.Type == "consumer" {
+				ch <- prometheus.MustNewConstMetric(topicConsumerLagDesc,
+					prometheus.GaugeValue, float64(t.ConsumerLag()), topic...)
+			}
+
+			for _, p := range t.Partitions {
+				if p.Partition < 0 {
+					// Skip the internal unassigned partition
+					continue
+				}
+				p := p
+				partition := withLabels(topic, strconv.Itoa(int(p.Partition)))
+
+				for _, m := range partitionMetrics {
+					ch <- prometheus.MustNewConstMetric(m.desc, m.valueType, m.value(&p), partition...)
+				}
+			}
+		}
+	}
+}
+
+// Register registers the Exporter with the Prometheus registerer reg.
+func (e *Exporter) Register(reg prometheus.Registerer) error {
+	return reg.Register(e)
+}
+
+// expvarStats is the expvar representation of a client's statistics.
+type expvarStats struct {
+	*kafka.Statistics
+	// ConsumerLag is the total consumer lag per topic
+	ConsumerLag map[string]int64 `json:"consumer_lag,omitempty"`
+}
+
+// PublishExpvar publishes the latest statistics of each client, by
+// client instance name, as the expvar variable name.
+// This is a fallback for applications not using Prometheus.
+func (e *Exporter) PublishExpvar(name string) {
+	expvar.Publish(name, expvar.Func(func() interface{} {
+		all := make(map[string]expvarStats)
+		stats, _ := e.snapshot()
+		for _, s := range stats {
+			v := expvarStats{Statistics: s}
+			if s.Type == "consumer" {
+				v.ConsumerLag = s.ConsumerLag()
+			}
+			all[s.Name] = v
+		}
+		return all
+	}))
+}
@@ -0,0 +1,170 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics
+
+import (
+	"encoding/json"
+	"expvar"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+const statsJSON = `{
+  "name": "rdkafka#consumer-1", "client_id": "rdkafka", "type": "consumer",
+  "replyq": 2, "tx": 10, "tx_bytes": 1000, "rx": 9, "rx_bytes": 9000,
+  "rxmsgs": 500, "rxmsg_bytes": 50000,
+  "brokers": {
+    "localhost:9092/1": {
+      "name": "localhost:9092/1", "nodeid": 1, "state": "UP",
+      "outbuf_cnt": 1, "waitresp_cnt": 2, "tx": 10, "rx": 9,
+      "connects": 1, "disconnects": 0,
+      "rtt": {"cnt": 4, "p50": 1000, "p90": 2000, "p95": 3000, "p99": 4000, "p99_99": 5000},
+      "throttle": {"cnt": 0}
+    }
+  },
+  "topics": {
+    "test": {
+      "topic": "test",
+      "partitions": {
+        "0": {"partition": 0, "fetchq_cnt": 5, "committed_offset": 1100,
+              "hi_offset": 1500, "consumer_lag": 400, "consumer_lag_stored": 310,
+              "rxmsgs": 500},
+        "-1": {"partition": -1, "consumer_lag": -1}
+      }
+    }
+  },
+  "cgrp": {"state": "up", "rebalance_cnt": 3, "assignment_size": 1}
+}`
+
+func testStatistics(t *testing.T) *kafka.Statistics {
+	var s kafka.Statistics
+	err := json.Unmarshal([]byte(statsJSON), &s)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	return &s
+}
+
+func TestExporterCollect(t *testing.T) {
+	assert := assert.New(t)
+
+	e := NewExporter()
+	reg := prometheus.NewPedanticRegistry()
+	assert.NoError(e.Register(reg))
+
+	count, err := testutil.GatherAndCount(reg)
+	assert.NoError(err)
+	assert.Zero(count, "No metrics should be exported before statistics")
+
+	e.UpdateStatistics(testStatistics(t))
+
+	expected := `
+# HELP kafka_client_rx_message_bytes_total Total number of message bytes consumed.
+# TYPE kafka_client_rx_message_bytes_total counter
+kafka_client_rx_message_bytes_total{client_id="rdkafka",name="rdkafka#consumer-1",type="consumer"} 50000
+# HELP kafka_consumer_group_rebalances_total Total number of rebalances (assign or revoke).
+# TYPE kafka_consumer_group_rebalances_total counter
+kafka_consumer_group_rebalances_total{client_id="rdkafka",name="rdkafka#consumer-1",type="consumer"} 3
+# HELP kafka_partition_consumer_lag Difference between the high watermark (or last stable) offset and the committed offset.
+# TYPE kafka_partition_consumer_lag gauge
+kafka_partition_consumer_lag{client_id="rdkafka",name="rdkafka#consumer-1",partition="0",topic="test",type="consumer"} 400
+# HELP kafka_topic_consumer_lag Total consumer lag of the topic's partitions.
+# TYPE kafka_topic_consumer_lag gauge
+kafka_topic_consumer_lag{client_id="rdkafka",name="rdkafka#consumer-1",topic="test",type="consumer"} 400
+`
+	assert.NoError(testutil.GatherAndCompare(reg, strings.NewReader(expected),
+		"kafka_client_rx_message_bytes_total",
+		"kafka_consumer_group_rebalances_total",
+		"kafka_partition_consumer_lag",
+		"kafka_topic_consumer_lag"))
+
+	// Latency windows are exported in seconds, empty windows are skipped.
+	count, err = testutil.GatherAndCount(reg, "kafka_broker_rtt_seconds")
+	assert.NoError(err)
+	assert.Equal(len(windowQuantiles), count)
+	count, err = testutil.GatherAndCount(reg, "kafka_broker_throttle_seconds")
+	assert.NoError(err)
+	assert.Zero(count)
+
+	e.Remove("rdkafka#consumer-1")
+	count, err = testutil.GatherAndCount(reg)
+	assert.NoError(err)
+	assert.Zero(count, "No metrics should be exported for removed clients")
+}
+
+func TestExporterUpdate(t *testing.T) {
+	assert := assert.New(t)
+
+	e := NewExporter()
+
+	// Update() is used as the go.stats.cb of the clients.
+	p, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers":      "127.0.0.1:65533",
+		"statistics.interval.ms": 50,
+		"go.stats.cb":            e.StatsCb(),
+	})
+	assert.NoError(err)
+	defer p.Close()
+
+	// The Stats events are still available to the application.
+	timeout := time.After(5 * time.Second)
+	for stats := false; !stats; {
+		select {
+		case ev := <-p.Events():
+			_, stats = ev.(*kafka.Stats)
+		case <-timeout:
+			t.Fatalf("Expected Stats event")
+		}
+	}
+
+	count := testutil.CollectAndCount(e, "kafka_client_tx_requests_total")
+	assert.Equal(1, count)
+
+	// Invalid statistics are counted per client: the client of empty
+	// statistics is unknown.
+	e.Update(&kafka.Stats{})
+	e.Update(&kafka.Stats{})
+	assert.Equal(map[statsClient]int64{{}: 2}, e.parseErrors, "Invalid statistics should be counted")
+	count = testutil.CollectAndCount(e, "kafka_stats_parse_errors_total")
+	assert.Equal(1, count)
+}
+
+func TestExporterPublishExpvar(t *testing.T) {
+	assert := assert.New(t)
+
+	e := NewExporter()
+	e.UpdateStatistics(testStatistics(t))
+	e.PublishExpvar("kafka_test")
+
+	var published map[string]struct {
+		Name        string           `json:"name"`
+		ReplyQ      int64            `json:"replyq"`
+		ConsumerLag map[string]int64 `json:"consumer_lag"`
+	}
+	assert.NoError(json.Unmarshal([]byte(expvar.Get("kafka_test").String()), &published))
+
+	s, found := published["rdkafka#consumer-1"]
+	assert.True(found)
+	assert.Equal(int64(2), s.ReplyQ)
+	assert.Equal(map[string]int64{"test": 400}, s.ConsumerLag)
+}
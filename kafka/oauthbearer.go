@@ -0,0 +1,161 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+/*
+#include "select_rdkafka.h"
+*/
+import "C"
+
+const (
+	// oauthBearerMaxAttempts is the number of attempts to retrieve and
+	// set a token on each token refresh, before the refresh fails.
+	oauthBearerMaxAttempts = 5
+	// oauthBearerRetryBackoff is the initial time to wait before retrying
+	// a failed token retrieval, doubled on each retry.
+	oauthBearerRetryBackoff = 500 * time.Millisecond
+	// oauthBearerRetryBackoffMax is the maximum time to wait before
+	// retrying a failed token retrieval.
+	oauthBearerRetryBackoffMax = 10 * time.Second
+)
+
+// OAuthBearerTokenProvider retrieves the SASL/OAUTHBEARER tokens of a
+// client, see the `go.oauthbearer.token.provider` configuration property.
+//
+// The client calls Token() whenever it needs a token: once created, and
+// when the previous token reaches 80% of its lifetime. Failed retrievals
+// are retried with an exponential backoff, and then again after 10
+// seconds.
+type OAuthBearerTokenProvider interface {
+	// Token retrieves a new token.
+	// config is the value of the `sasl.oauthbearer.config` property.
+	// ctx is cancelled when the client is closed.
+	Token(ctx context.Context, config string) (OAuthBearerToken, error)
+}
+
+// extractOAuthBearerTokenProvider extracts the
+// go.oauthbearer.token.provider configuration property.
+func (m ConfigMap) extractOAuthBearerTokenProvider() (OAuthBearerTokenProvider, error) {
+	v, err := m.extract("go.oauthbearer.token.provider", nil)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	provider, ok := v.(OAuthBearerTokenProvider)
+	if !ok {
+		return nil, newErrorFromString(ErrInvalidArg,
+			fmt.Sprintf("go.oauthbearer.token.provider expects type kafka.OAuthBearerTokenProvider, not %T", v))
+	}
+
+	return provider, nil
+}
+
+// enableSaslQueue makes the client instance of cConf emit its
+// OAuthBearerTokenRefresh events on the SASL queue, served by
+// setupOAuthBearerTokenProvider(), instead of the main queue.
+func enableSaslQueue(cConf *C.rd_kafka_conf_t) {
+	C.rd_kafka_conf_enable_sasl_queue(cConf, 1)
+}
+
+// setupOAuthBearerTokenProvider serves the SASL queue of the client
+// instance, setting the tokens retrieved with provider, until termChan is
+// closed.
+// The SASL queue must have been enabled with enableSaslQueue().
+func (h *handle) setupOAuthBearerTokenProvider(provider OAuthBearerTokenProvider, termChan chan bool) {
+	h.saslq = C.rd_kafka_queue_get_sasl(h.rk)
+
+	h.waitGroup.Add(1)
+	go func() {
+		defer h.waitGroup.Done()
+		h.pollOAuthBearerTokenRefresh(provider, termChan)
+	}()
+}
+
+// pollOAuthBearerTokenRefresh serves the token refresh events of the SASL
+// queue with provider, until termChan is closed.
+func (h *handle) pollOAuthBearerTokenRefresh(provider OAuthBearerTokenProvider, termChan chan bool) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-termChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		select {
+		case <-termChan:
+			return
+
+		default:
+			rkev := C.rd_kafka_queue_poll(h.saslq, 100)
+			if rkev == nil {
+				continue
+			}
+
+			if C.rd_kafka_event_type(rkev) != C.RD_KAFKA_EVENT_OAUTHBEARER_TOKEN_REFRESH {
+				C.rd_kafka_event_destroy(rkev)
+				continue
+			}
+
+			config := C.GoString(C.rd_kafka_event_config_string(rkev))
+			C.rd_kafka_event_destroy(rkev)
+
+			h.refreshOAuthBearerToken(ctx, provider, config)
+		}
+	}
+}
+
+// refreshOAuthBearerToken retrieves and sets a new token with provider,
+// retrying with an exponential backoff, or sets the token failure, which
+// makes librdkafka request a new refresh 10 seconds later.
+func (h *handle) refreshOAuthBearerToken(ctx context.Context, provider OAuthBearerTokenProvider, config string) {
+	backoff := oauthBearerRetryBackoff
+
+	for attempt := 1; ; attempt++ {
+		token, err := provider.Token(ctx, config)
+		if err == nil {
+			err = h.setOAuthBearerToken(token)
+			if err == nil {
+				return
+			}
+		}
+
+		if attempt == oauthBearerMaxAttempts || ctx.Err() != nil {
+			h.setOAuthBearerTokenFailure(err.Error())
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > oauthBearerRetryBackoffMax {
+			backoff = oauthBearerRetryBackoffMax
+		}
+	}
+}
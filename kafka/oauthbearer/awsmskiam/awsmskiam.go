@@ -0,0 +1,192 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package awsmskiam generates the SASL/OAUTHBEARER tokens of Amazon MSK
+// IAM access control: presigned, with Signature Version 4, `Connect`
+// requests to the cluster, authenticating the client with its AWS
+// credentials.
+//
+// The TokenProvider is set with the `go.oauthbearer.token.provider`
+// configuration property, and is called by the client whenever it needs a
+// new token, before the current token expires:
+//
+//	provider, err := awsmskiam.NewTokenProvider(ctx,
+//		awsmskiam.Config{Region: "us-east-1"})
+//
+//	p, err := kafka.NewProducer(&kafka.ConfigMap{
+//		"bootstrap.servers":             "b-1.mycluster.kafka.us-east-1.amazonaws.com:9098",
+//		"security.protocol":             "SASL_SSL",
+//		"sasl.mechanisms":               "OAUTHBEARER",
+//		"go.oauthbearer.token.provider": provider,
+//	})
+//
+// Alternatively, applications handling the OAuthBearerTokenRefresh events
+// themselves may serve them with TokenProvider.Refresh().
+package awsmskiam
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+const (
+	// signingName is the SigV4 service name of MSK IAM tokens.
+	signingName = "kafka-cluster"
+	// connectAction is the action of the presigned requests.
+	connectAction = "kafka-cluster:Connect"
+	// tokenLifetime is the lifetime of the presigned requests.
+	tokenLifetime = 15 * time.Minute
+	// userAgent identifies the client to the MSK brokers.
+	userAgent = "confluent-kafka-go"
+)
+
+// emptyPayloadHash is the SHA-256 hash of the empty payload of the
+// presigned requests.
+var emptyPayloadHash = hexSHA256("")
+
+// Config is the configuration of a TokenProvider.
+type Config struct {
+	// Region is the AWS region of the MSK cluster.
+	// Defaults to the region of the shared configuration or environment.
+	Region string
+	// Profile is the shared configuration profile of the default
+	// credentials and region, if not the default profile.
+	Profile string
+	// Credentials are the AWS credentials authenticating the client.
+	// Defaults to the default credential chain: the environment variables,
+	// the shared configuration and credentials files, the web identity
+	// token file of AWS_WEB_IDENTITY_TOKEN_FILE and AWS_ROLE_ARN, and the
+	// container and instance roles.
+	Credentials aws.CredentialsProvider
+	// Principal is the Kafka principal name of the tokens, which is only
+	// used locally. Defaults to the access key ID of the credentials.
+	Principal string
+}
+
+// TokenProvider is a kafka.OAuthBearerTokenProvider generating Amazon MSK
+// IAM tokens.
+//
+// The tokens expire after 15 minutes, or when the credentials that signed
+// them expire, if sooner, and are refreshed by the client at 80% of their
+// lifetime.
+type TokenProvider struct {
+	region      string
+	credentials aws.CredentialsProvider
+	principal   string
+}
+
+// NewTokenProvider returns a new TokenProvider, loading the default AWS
+// configuration unless both config.Region and config.Credentials are set.
+func NewTokenProvider(ctx context.Context, cfg Config) (*TokenProvider, error) {
+	if cfg.Region == "" || cfg.Credentials == nil {
+		var opts []func(*config.LoadOptions) error
+		if cfg.Profile != "" {
+			opts = append(opts, config.WithSharedConfigProfile(cfg.Profile))
+		}
+		awsConfig, err := config.LoadDefaultConfig(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if cfg.Region == "" {
+			cfg.Region = awsConfig.Region
+		}
+		if cfg.Credentials == nil {
+			cfg.Credentials = awsConfig.Credentials
+		}
+	}
+
+	if cfg.Region == "" {
+		return nil, kafka.NewError(kafka.ErrInvalidArg, "No AWS region configured", false)
+	}
+	if cfg.Credentials == nil {
+		return nil, kafka.NewError(kafka.ErrInvalidArg, "No AWS credentials configured", false)
+	}
+
+	return &TokenProvider{
+		region:      cfg.Region,
+		credentials: cfg.Credentials,
+		principal:   cfg.Principal,
+	}, nil
+}
+
+// Token generates a new token, implementing kafka.OAuthBearerTokenProvider.
+func (p *TokenProvider) Token(ctx context.Context, config string) (kafka.OAuthBearerToken, error) {
+	creds, err := p.credentials.Retrieve(ctx)
+	if err != nil {
+		return kafka.OAuthBearerToken{}, fmt.Errorf("failed to retrieve AWS credentials: %w", err)
+	}
+
+	return newToken(p.region, creds, p.principal, time.Now()), nil
+}
+
+// Refresh generates a new token and sets it on client, or sets the token
+// failure, serving an OAuthBearerTokenRefresh event of client.
+func (p *TokenProvider) Refresh(ctx context.Context, client kafka.Handle) error {
+	token, err := p.Token(ctx, "")
+	if err == nil {
+		err = client.SetOAuthBearerToken(token)
+	}
+	if err != nil {
+		client.SetOAuthBearerTokenFailure(err.Error())
+		return err
+	}
+	return nil
+}
+
+// newToken returns the MSK IAM token of region, signed with creds at
+// signingTime: the base64url encoding of the presigned Connect request
+// URL.
+func newToken(region string, creds aws.Credentials, principal string, signingTime time.Time) kafka.OAuthBearerToken {
+	u := &url.URL{
+		Scheme:   "https",
+		Host:     fmt.Sprintf("kafka.%s.amazonaws.com", region),
+		Path:     "/",
+		RawQuery: url.Values{"Action": {connectAction}}.Encode(),
+	}
+
+	signed := presignURL(u, emptyPayloadHash, creds, signingName, region,
+		signingTime, tokenLifetime)
+
+	// The User-Agent is not signed.
+	query := signed.Query()
+	query.Set("User-Agent", userAgent)
+	signed.RawQuery = query.Encode()
+
+	// The token expires with the presigned request, truncated to the
+	// second as X-Amz-Date, or with the credentials.
+	expiration := signingTime.Truncate(time.Second).Add(tokenLifetime)
+	if creds.CanExpire && creds.Expires.Before(expiration) {
+		expiration = creds.Expires
+	}
+
+	if principal == "" {
+		principal = creds.AccessKeyID
+	}
+
+	return kafka.OAuthBearerToken{
+		TokenValue: base64.RawURLEncoding.EncodeToString([]byte(signed.String())),
+		Expiration: expiration,
+		Principal:  principal,
+	}
+}
@@ -0,0 +1,135 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package awsmskiam
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+const (
+	sigV4Algorithm  = "AWS4-HMAC-SHA256"
+	sigV4TimeFormat = "20060102T150405Z"
+	sigV4DateFormat = "20060102"
+)
+
+// presignURL returns u presigned for a GET request with Signature Version 4
+// query parameters, as per
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html
+//
+// Only the host header is signed. payloadHash is the hex encoded SHA-256
+// hash of the request payload, or "UNSIGNED-PAYLOAD".
+func presignURL(u *url.URL, payloadHash string, creds aws.Credentials,
+	service string, region string, signingTime time.Time, expires time.Duration) *url.URL {
+	signingTime = signingTime.UTC()
+	scope := strings.Join([]string{
+		signingTime.Format(sigV4DateFormat), region, service, "aws4_request"}, "/")
+
+	query := u.Query()
+	query.Set("X-Amz-Algorithm", sigV4Algorithm)
+	query.Set("X-Amz-Credential", creds.AccessKeyID+"/"+scope)
+	query.Set("X-Amz-Date", signingTime.Format(sigV4TimeFormat))
+	query.Set("X-Amz-Expires", strconv.FormatInt(int64(expires/time.Second), 10))
+	query.Set("X-Amz-SignedHeaders", "host")
+	if creds.SessionToken != "" {
+		query.Set("X-Amz-Security-Token", creds.SessionToken)
+	}
+
+	canonicalPath := u.EscapedPath()
+	if canonicalPath == "" {
+		canonicalPath = "/"
+	}
+	canonicalQuery := canonicalQueryString(query)
+
+	canonicalRequest := strings.Join([]string{
+		"GET",
+		canonicalPath,
+		canonicalQuery,
+		"host:" + strings.ToLower(u.Host) + "\n",
+		"host",
+		payloadHash,
+	}, "\n")
+
+	stringToSign := strings.Join([]string{
+		sigV4Algorithm,
+		signingTime.Format(sigV4TimeFormat),
+		scope,
+		hexSHA256(canonicalRequest),
+	}, "\n")
+
+	key := signingKey(creds.SecretAccessKey, signingTime, region, service)
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	presigned := *u
+	presigned.RawQuery = canonicalQuery + "&X-Amz-Signature=" + signature
+	return &presigned
+}
+
+// signingKey returns the SigV4 signing key of secretAccessKey for the
+// date of signingTime, region and service.
+func signingKey(secretAccessKey string, signingTime time.Time, region string, service string) []byte {
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), signingTime.UTC().Format(sigV4DateFormat))
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	return hmacSHA256(key, "aws4_request")
+}
+
+// canonicalQueryString returns the SigV4 canonical query string of query:
+// the URI encoded parameters sorted by name, and then by value.
+func canonicalQueryString(query url.Values) string {
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	params := make([]string, 0, len(query))
+	for _, name := range names {
+		values := append([]string(nil), query[name]...)
+		sort.Strings(values)
+		for _, value := range values {
+			params = append(params, uriEncode(name)+"="+uriEncode(value))
+		}
+	}
+
+	return strings.Join(params, "&")
+}
+
+// uriEncode percent-encodes every character of s but the unreserved
+// characters of RFC 3986, as SigV4 requires.
+func uriEncode(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func hexSHA256(data string) string {
+	h := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(h[:])
+}
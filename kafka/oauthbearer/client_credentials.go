@@ -0,0 +1,99 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package oauthbearer
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// ClientCredentialsConfig is the configuration of a
+// ClientCredentialsProvider.
+type ClientCredentialsConfig struct {
+	TokenOptions
+
+	// TokenURL is the token endpoint of the authorization server.
+	TokenURL string
+	// ClientID is the client identifier.
+	ClientID string
+	// ClientSecret is the client secret.
+	ClientSecret string
+	// Scopes are the requested scopes, if any.
+	Scopes []string
+	// EndpointParams are additional parameters of the token requests.
+	EndpointParams url.Values
+	// HTTPClient is the client of the token requests.
+	// Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// ClientCredentialsProvider is a kafka.OAuthBearerTokenProvider requesting
+// tokens with the OAuth 2.0 client credentials grant (RFC 6749 section 4.4).
+//
+// The token principal defaults to the `sub` claim of JWT tokens, else to
+// the client identifier.
+type ClientCredentialsProvider struct {
+	config     clientcredentials.Config
+	options    TokenOptions
+	httpClient *http.Client
+}
+
+// NewClientCredentialsProvider returns a new ClientCredentialsProvider.
+func NewClientCredentialsProvider(config ClientCredentialsConfig) (*ClientCredentialsProvider, error) {
+	if config.TokenURL == "" {
+		return nil, invalidConfig("TokenURL is required")
+	}
+	if config.ClientID == "" {
+		return nil, invalidConfig("ClientID is required")
+	}
+
+	return &ClientCredentialsProvider{
+		config: clientcredentials.Config{
+			ClientID:       config.ClientID,
+			ClientSecret:   config.ClientSecret,
+			TokenURL:       config.TokenURL,
+			Scopes:         config.Scopes,
+			EndpointParams: config.EndpointParams,
+		},
+		options:    config.TokenOptions,
+		httpClient: config.HTTPClient,
+	}, nil
+}
+
+// Token requests a new token, implementing kafka.OAuthBearerTokenProvider.
+func (p *ClientCredentialsProvider) Token(ctx context.Context, config string) (kafka.OAuthBearerToken, error) {
+	token, err := p.config.Token(withHTTPClient(ctx, p.httpClient))
+	if err != nil {
+		return kafka.OAuthBearerToken{}, err
+	}
+
+	return newToken(token.AccessToken, token.Expiry, p.options, p.config.ClientID)
+}
+
+// withHTTPClient returns ctx, with httpClient as the client of the oauth2
+// token requests, if set.
+func withHTTPClient(ctx context.Context, httpClient *http.Client) context.Context {
+	if httpClient == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, oauth2.HTTPClient, httpClient)
+}
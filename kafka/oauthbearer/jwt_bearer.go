@@ -0,0 +1,301 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package oauthbearer
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	// jwtBearerGrantType is the grant type of RFC 7523 section 2.1.
+	jwtBearerGrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	// defaultAssertionLifetime is the lifetime of the assertions, unless
+	// configured otherwise.
+	defaultAssertionLifetime = 5 * time.Minute
+)
+
+// JWTBearerConfig is the configuration of a JWTBearerProvider.
+type JWTBearerConfig struct {
+	TokenOptions
+
+	// TokenURL is the token endpoint of the authorization server.
+	TokenURL string
+	// Issuer is the `iss` claim of the assertions.
+	Issuer string
+	// Subject is the `sub` claim of the assertions.
+	// Defaults to Issuer.
+	Subject string
+	// Audience is the `aud` claim of the assertions.
+	// Defaults to TokenURL.
+	Audience string
+	// Scopes are the requested scopes, if any.
+	Scopes []string
+	// PrivateKey signs the assertions: RSA keys sign with RS256, ECDSA keys
+	// with ES256, ES384 or ES512 depending on their curve, and Ed25519 keys
+	// with EdDSA.
+	PrivateKey crypto.Signer
+	// KeyID is the `kid` header of the assertions, if any.
+	KeyID string
+	// AssertionLifetime is the lifetime of the assertions.
+	// Defaults to 5 minutes.
+	AssertionLifetime time.Duration
+	// Claims are additional claims of the assertions, if any.
+	Claims map[string]interface{}
+	// ClientID is the client identifier, if the authorization server
+	// requires client authentication.
+	ClientID string
+	// ClientSecret is the client secret, if the authorization server
+	// requires client authentication.
+	ClientSecret string
+	// HTTPClient is the client of the token requests.
+	// Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// JWTBearerProvider is a kafka.OAuthBearerTokenProvider requesting tokens
+// with a JWT bearer assertion grant (RFC 7523 section 2.1), signing the
+// assertions with a local private key.
+//
+// The token principal defaults to the `sub` claim of JWT tokens, else to
+// the assertion's subject.
+type JWTBearerProvider struct {
+	config     JWTBearerConfig
+	alg        string
+	httpClient *http.Client
+}
+
+// NewJWTBearerProvider returns a new JWTBearerProvider.
+func NewJWTBearerProvider(config JWTBearerConfig) (*JWTBearerProvider, error) {
+	if config.TokenURL == "" {
+		return nil, invalidConfig("TokenURL is required")
+	}
+	if config.Issuer == "" {
+		return nil, invalidConfig("Issuer is required")
+	}
+	if config.PrivateKey == nil {
+		return nil, invalidConfig("PrivateKey is required")
+	}
+
+	alg, err := signingAlgorithm(config.PrivateKey.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Subject == "" {
+		config.Subject = config.Issuer
+	}
+	if config.Audience == "" {
+		config.Audience = config.TokenURL
+	}
+	if config.AssertionLifetime <= 0 {
+		config.AssertionLifetime = defaultAssertionLifetime
+	}
+
+	return &JWTBearerProvider{
+		config:     config,
+		alg:        alg,
+		httpClient: config.HTTPClient,
+	}, nil
+}
+
+// Token requests a new token with a new assertion, implementing
+// kafka.OAuthBearerTokenProvider.
+func (p *JWTBearerProvider) Token(ctx context.Context, config string) (kafka.OAuthBearerToken, error) {
+	assertion, err := p.assertion(time.Now())
+	if err != nil {
+		return kafka.OAuthBearerToken{}, err
+	}
+
+	authStyle := oauth2.AuthStyleAutoDetect
+	if p.config.ClientSecret == "" {
+		authStyle = oauth2.AuthStyleInParams
+	}
+
+	tokenConfig := clientcredentials.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		TokenURL:     p.config.TokenURL,
+		Scopes:       p.config.Scopes,
+		EndpointParams: url.Values{
+			"grant_type": {jwtBearerGrantType},
+			"assertion":  {assertion},
+		},
+		AuthStyle: authStyle,
+	}
+
+	token, err := tokenConfig.Token(withHTTPClient(ctx, p.httpClient))
+	if err != nil {
+		return kafka.OAuthBearerToken{}, err
+	}
+
+	return newToken(token.AccessToken, token.Expiry, p.config.TokenOptions, p.config.Subject)
+}
+
+// assertion returns a new signed assertion, issued at now.
+func (p *JWTBearerProvider) assertion(now time.Time) (string, error) {
+	header := map[string]interface{}{
+		"alg": p.alg,
+		"typ": "JWT",
+	}
+	if p.config.KeyID != "" {
+		header["kid"] = p.config.KeyID
+	}
+
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+
+	claims := make(map[string]interface{}, len(p.config.Claims)+6)
+	for name, value := range p.config.Claims {
+		claims[name] = value
+	}
+	claims["iss"] = p.config.Issuer
+	claims["sub"] = p.config.Subject
+	claims["aud"] = p.config.Audience
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(p.config.AssertionLifetime).Unix()
+	claims["jti"] = hex.EncodeToString(jti)
+
+	encodedHeader, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	encodedClaims, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("invalid assertion claims: %w", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(encodedHeader) + "." +
+		base64.RawURLEncoding.EncodeToString(encodedClaims)
+
+	signature, err := sign(p.config.PrivateKey, p.alg, []byte(signingInput))
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// signingAlgorithm returns the JWS algorithm of the key pair of publicKey.
+func signingAlgorithm(publicKey crypto.PublicKey) (string, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return "RS256", nil
+	case *ecdsa.PublicKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return "ES256", nil
+		case elliptic.P384():
+			return "ES384", nil
+		case elliptic.P521():
+			return "ES512", nil
+		}
+		return "", invalidConfig("Unsupported ECDSA curve %s", key.Curve.Params().Name)
+	case ed25519.PublicKey:
+		return "EdDSA", nil
+	}
+	return "", invalidConfig("Unsupported private key type %T", publicKey)
+}
+
+// sign returns the JWS signature of signingInput with signer, for the
+// algorithm alg.
+func sign(signer crypto.Signer, alg string, signingInput []byte) ([]byte, error) {
+	var hash crypto.Hash
+	var keySize int
+	switch alg {
+	case "EdDSA":
+		return signer.Sign(rand.Reader, signingInput, crypto.Hash(0))
+	case "RS256", "ES256":
+		hash, keySize = crypto.SHA256, 32
+	case "ES384":
+		hash, keySize = crypto.SHA384, 48
+	case "ES512":
+		hash, keySize = crypto.SHA512, 66
+	}
+
+	h := hash.New()
+	h.Write(signingInput)
+	signature, err := signer.Sign(rand.Reader, h.Sum(nil), hash)
+	if err != nil || alg == "RS256" {
+		return signature, err
+	}
+
+	// ECDSA signers return an ASN.1 signature, while JWS signatures are the
+	// concatenation of the fixed size R and S values (RFC 7518 section 3.4).
+	var ecdsaSignature struct {
+		R, S *big.Int
+	}
+	if _, err = asn1.Unmarshal(signature, &ecdsaSignature); err != nil {
+		return nil, fmt.Errorf("invalid ECDSA signature: %w", err)
+	}
+
+	signature = make([]byte, 2*keySize)
+	ecdsaSignature.R.FillBytes(signature[:keySize])
+	ecdsaSignature.S.FillBytes(signature[keySize:])
+	return signature, nil
+}
+
+// ParsePrivateKeyPEM parses a PEM encoded PKCS #1 RSA, SEC 1 EC or PKCS #8
+// private key, for JWTBearerConfig.PrivateKey.
+func ParsePrivateKeyPEM(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, invalidConfig("No PEM private key found")
+	}
+
+	var key interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, invalidConfig("Invalid private key: %s", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, invalidConfig("Unsupported private key type %T", key)
+	}
+	return signer, nil
+}
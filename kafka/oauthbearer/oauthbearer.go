@@ -0,0 +1,139 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package oauthbearer provides kafka.OAuthBearerTokenProvider
+// implementations, retrieving the SASL/OAUTHBEARER tokens of Producer,
+// Consumer and AdminClient instances:
+//
+//   - ClientCredentialsProvider requests tokens with the OAuth 2.0 client
+//     credentials grant (RFC 6749).
+//   - JWTBearerProvider requests tokens with a JWT bearer assertion signed
+//     with a local private key (RFC 7523).
+//   - TokenFileProvider reads tokens from a file, re-reading it when it
+//     changes, such as a Kubernetes projected service account token.
+//
+// The provider is set with the `go.oauthbearer.token.provider`
+// configuration property, and is called by the client whenever it needs a
+// new token:
+//
+//	provider, err := oauthbearer.NewClientCredentialsProvider(
+//		oauthbearer.ClientCredentialsConfig{
+//			TokenURL:     "https://idp.example.com/oauth2/token",
+//			ClientID:     "myclient",
+//			ClientSecret: "mysecret",
+//		})
+//
+//	p, err := kafka.NewProducer(&kafka.ConfigMap{
+//		"bootstrap.servers":             "localhost:9092",
+//		"security.protocol":             "SASL_SSL",
+//		"sasl.mechanisms":               "OAUTHBEARER",
+//		"go.oauthbearer.token.provider": provider,
+//	})
+//
+// The value of the `sasl.oauthbearer.config` property is ignored by the
+// providers of this package.
+package oauthbearer
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// defaultPrincipalClaim is the JWT claim of the token principal, unless
+// configured otherwise.
+const defaultPrincipalClaim = "sub"
+
+// TokenOptions are the options common to all the providers, setting up the
+// tokens retrieved.
+type TokenOptions struct {
+	// Principal is the Kafka principal name of the tokens.
+	// Defaults to the PrincipalClaim of JWT tokens.
+	Principal string
+	// PrincipalClaim is the JWT claim of the principal name, used when
+	// Principal is not set. Defaults to "sub".
+	PrincipalClaim string
+	// Extensions are the SASL extensions of the tokens, if any.
+	Extensions map[string]string
+}
+
+// newToken returns the token of accessToken, expiring at expiry, or at the
+// `exp` claim of JWT tokens if expiry is zero.
+// The principal is found as per options, falling back to
+// defaultPrincipal.
+func newToken(accessToken string, expiry time.Time, options TokenOptions, defaultPrincipal string) (kafka.OAuthBearerToken, error) {
+	claims, _ := jwtClaims(accessToken)
+
+	if expiry.IsZero() {
+		exp, ok := claims["exp"].(float64)
+		if !ok {
+			return kafka.OAuthBearerToken{}, fmt.Errorf("token has no expiry")
+		}
+		expiry = time.Unix(int64(exp), 0)
+	}
+
+	principal := options.Principal
+	if principal == "" {
+		principalClaim := options.PrincipalClaim
+		if principalClaim == "" {
+			principalClaim = defaultPrincipalClaim
+		}
+		principal, _ = claims[principalClaim].(string)
+	}
+	if principal == "" {
+		principal = defaultPrincipal
+	}
+	if principal == "" {
+		return kafka.OAuthBearerToken{}, fmt.Errorf("token has no principal")
+	}
+
+	return kafka.OAuthBearerToken{
+		TokenValue: accessToken,
+		Expiration: expiry,
+		Principal:  principal,
+		Extensions: options.Extensions,
+	}, nil
+}
+
+// jwtClaims returns the claims of a JWT token, without verifying it.
+func jwtClaims(token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("token is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT payload: %w", err)
+	}
+
+	var claims map[string]interface{}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("invalid JWT claims: %w", err)
+	}
+
+	return claims, nil
+}
+
+// invalidConfig returns the ErrInvalidArg error of an invalid provider
+// configuration.
+func invalidConfig(format string, args ...interface{}) error {
+	return kafka.NewError(kafka.ErrInvalidArg, fmt.Sprintf(format, args...), false)
+}
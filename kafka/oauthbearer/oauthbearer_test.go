@@ -0,0 +1,292 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package oauthbearer
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+// testJWT returns an unsigned JWT with claims.
+func testJWT(claims map[string]interface{}) string {
+	payload, _ := json.Marshal(claims)
+	return base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`)) + "." +
+		base64.RawURLEncoding.EncodeToString(payload) + ".sig"
+}
+
+// newTokenServer returns a token endpoint passing the form of each request
+// to requests, and responding with accessToken.
+func newTokenServer(t *testing.T, accessToken string, expiresIn int, requests chan<- url.Values) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		requests <- r.PostForm
+
+		w.Header().Set("Content-Type", "application/json")
+		response := map[string]interface{}{
+			"access_token": accessToken,
+			"token_type":   "Bearer",
+		}
+		if expiresIn > 0 {
+			response["expires_in"] = expiresIn
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+}
+
+func TestClientCredentialsProvider(t *testing.T) {
+	requests := make(chan url.Values, 1)
+	server := newTokenServer(t, "opaque-token", 3600, requests)
+	defer server.Close()
+
+	_, err := NewClientCredentialsProvider(ClientCredentialsConfig{ClientID: "myclient"})
+	assert.Equal(t, kafka.ErrInvalidArg, err.(kafka.Error).Code())
+
+	provider, err := NewClientCredentialsProvider(ClientCredentialsConfig{
+		TokenURL:       server.URL,
+		ClientID:       "myclient",
+		ClientSecret:   "mysecret",
+		Scopes:         []string{"kafka", "admin"},
+		EndpointParams: url.Values{"audience": {"mycluster"}},
+		TokenOptions: TokenOptions{
+			Extensions: map[string]string{"logicalCluster": "lkc-1"},
+		},
+	})
+	assert.NoError(t, err)
+
+	before := time.Now()
+	token, err := provider.Token(context.Background(), "")
+	assert.NoError(t, err)
+
+	form := <-requests
+	assert.Equal(t, "client_credentials", form.Get("grant_type"))
+	assert.Equal(t, "kafka admin", form.Get("scope"))
+	assert.Equal(t, "mycluster", form.Get("audience"))
+
+	assert.Equal(t, "opaque-token", token.TokenValue)
+	assert.Equal(t, "myclient", token.Principal)
+	assert.Equal(t, map[string]string{"logicalCluster": "lkc-1"}, token.Extensions)
+	assert.WithinDuration(t, before.Add(time.Hour), token.Expiration, 10*time.Second)
+
+	// A JWT access token without expires_in: expiry and principal from
+	// its claims.
+	exp := time.Now().Add(30 * time.Minute).Truncate(time.Second)
+	jwtServer := newTokenServer(t,
+		testJWT(map[string]interface{}{"exp": exp.Unix(), "sub": "alice", "azp": "bob"}),
+		0, requests)
+	defer jwtServer.Close()
+
+	provider, err = NewClientCredentialsProvider(ClientCredentialsConfig{
+		TokenURL:     jwtServer.URL,
+		ClientID:     "myclient",
+		TokenOptions: TokenOptions{PrincipalClaim: "azp"},
+		HTTPClient:   jwtServer.Client(),
+	})
+	assert.NoError(t, err)
+
+	token, err = provider.Token(context.Background(), "")
+	assert.NoError(t, err)
+	<-requests
+	assert.Equal(t, "bob", token.Principal)
+	assert.True(t, exp.Equal(token.Expiration), "Expected expiration %v, not %v", exp, token.Expiration)
+}
+
+// verifyAssertion verifies the signature of assertion with publicKey,
+// returning its header and claims.
+func verifyAssertion(t *testing.T, assertion string, publicKey crypto.PublicKey) (header map[string]interface{}, claims map[string]interface{}) {
+	parts := strings.Split(assertion, ".")
+	if !assert.Len(t, parts, 3) {
+		return nil, nil
+	}
+
+	for i, v := range []interface{}{&header, &claims} {
+		data, err := base64.RawURLEncoding.DecodeString(parts[i])
+		assert.NoError(t, err)
+		assert.NoError(t, json.Unmarshal(data, v))
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	assert.NoError(t, err)
+	signingInput := []byte(parts[0] + "." + parts[1])
+
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(signingInput)
+		assert.NoError(t, rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature))
+	case *ecdsa.PublicKey:
+		digest := sha512.Sum384(signingInput)
+		assert.Len(t, signature, 96)
+		r := new(big.Int).SetBytes(signature[:48])
+		s := new(big.Int).SetBytes(signature[48:])
+		assert.True(t, ecdsa.Verify(key, digest[:], r, s), "Invalid ECDSA signature")
+	}
+
+	return header, claims
+}
+
+func TestJWTBearerProvider(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	assert.NoError(t, err)
+
+	requests := make(chan url.Values, 1)
+	server := newTokenServer(t, "opaque-token", 600, requests)
+	defer server.Close()
+
+	_, err = NewJWTBearerProvider(JWTBearerConfig{TokenURL: server.URL, Issuer: "myclient"})
+	assert.Equal(t, kafka.ErrInvalidArg, err.(kafka.Error).Code())
+
+	for _, test := range []struct {
+		key crypto.Signer
+		alg string
+	}{
+		{rsaKey, "RS256"},
+		{ecKey, "ES384"},
+	} {
+		provider, err := NewJWTBearerProvider(JWTBearerConfig{
+			TokenURL:   server.URL,
+			Issuer:     "myclient",
+			Subject:    "myservice",
+			Scopes:     []string{"kafka"},
+			PrivateKey: test.key,
+			KeyID:      "key-1",
+			Claims:     map[string]interface{}{"tenant": "mytenant"},
+		})
+		assert.NoError(t, err)
+
+		before := time.Now()
+		token, err := provider.Token(context.Background(), "")
+		assert.NoError(t, err)
+
+		form := <-requests
+		assert.Equal(t, jwtBearerGrantType, form.Get("grant_type"))
+		assert.Equal(t, "kafka", form.Get("scope"))
+		assert.Empty(t, form.Get("client_id"))
+
+		header, claims := verifyAssertion(t, form.Get("assertion"), test.key.Public())
+		assert.Equal(t, test.alg, header["alg"])
+		assert.Equal(t, "key-1", header["kid"])
+		assert.Equal(t, "myclient", claims["iss"])
+		assert.Equal(t, "myservice", claims["sub"])
+		assert.Equal(t, server.URL, claims["aud"])
+		assert.Equal(t, "mytenant", claims["tenant"])
+		assert.NotEmpty(t, claims["jti"])
+		assert.InDelta(t, before.Add(defaultAssertionLifetime).Unix(), claims["exp"], 10)
+
+		assert.Equal(t, "opaque-token", token.TokenValue)
+		assert.Equal(t, "myservice", token.Principal)
+		assert.WithinDuration(t, before.Add(10*time.Minute), token.Expiration, 10*time.Second)
+	}
+}
+
+func TestParsePrivateKeyPEM(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	ecDER, err := x509.MarshalECPrivateKey(ecKey)
+	assert.NoError(t, err)
+	pkcs8DER, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	assert.NoError(t, err)
+
+	for _, block := range []*pem.Block{
+		{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)},
+		{Type: "EC PRIVATE KEY", Bytes: ecDER},
+		{Type: "PRIVATE KEY", Bytes: pkcs8DER},
+	} {
+		signer, err := ParsePrivateKeyPEM(pem.EncodeToMemory(block))
+		assert.NoError(t, err, block.Type)
+		assert.NotNil(t, signer, block.Type)
+	}
+
+	_, err = ParsePrivateKeyPEM([]byte("not a key"))
+	assert.Equal(t, kafka.ErrInvalidArg, err.(kafka.Error).Code())
+}
+
+func TestTokenFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+
+	provider, err := NewTokenFileProvider(TokenFileConfig{Path: path})
+	assert.NoError(t, err)
+
+	_, err = provider.Token(context.Background(), "")
+	assert.Error(t, err)
+
+	writeToken := func(sub string, exp time.Time, modTime time.Time) string {
+		jwt := testJWT(map[string]interface{}{"sub": sub, "exp": exp.Unix()})
+		assert.NoError(t, os.WriteFile(path, []byte(jwt+"\n"), 0600))
+		assert.NoError(t, os.Chtimes(path, modTime, modTime))
+		return jwt
+	}
+
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	modTime := time.Now().Add(-time.Minute)
+	jwt := writeToken("alice", exp, modTime)
+
+	token, err := provider.Token(context.Background(), "")
+	assert.NoError(t, err)
+	assert.Equal(t, jwt, token.TokenValue)
+	assert.Equal(t, "alice", token.Principal)
+	assert.True(t, exp.Equal(token.Expiration), "Expected expiration %v, not %v", exp, token.Expiration)
+
+	// The cached token is returned while the file is unchanged.
+	assert.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf("%-*s", len(jwt)+1, "x")), 0600))
+	assert.NoError(t, os.Chtimes(path, modTime, modTime))
+	token, err = provider.Token(context.Background(), "")
+	assert.NoError(t, err)
+	assert.Equal(t, jwt, token.TokenValue)
+
+	// The file is re-read once changed.
+	exp = exp.Add(time.Hour)
+	jwt = writeToken("bob", exp, time.Now())
+	token, err = provider.Token(context.Background(), "")
+	assert.NoError(t, err)
+	assert.Equal(t, jwt, token.TokenValue)
+	assert.Equal(t, "bob", token.Principal)
+	assert.True(t, exp.Equal(token.Expiration), "Expected expiration %v, not %v", exp, token.Expiration)
+
+	// A token without expiry is rejected.
+	assert.NoError(t, os.WriteFile(path, []byte(testJWT(map[string]interface{}{"sub": "carol"})), 0600))
+	modTime = time.Now().Add(time.Minute)
+	assert.NoError(t, os.Chtimes(path, modTime, modTime))
+	_, err = provider.Token(context.Background(), "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,102 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package oauthbearer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// TokenFileConfig is the configuration of a TokenFileProvider.
+type TokenFileConfig struct {
+	TokenOptions
+
+	// Path is the path of the token file.
+	Path string
+}
+
+// TokenFileProvider is a kafka.OAuthBearerTokenProvider reading JWT tokens
+// from a file, such as a Kubernetes projected service account token.
+//
+// The file is re-read when its modification time or size changes, which
+// includes it being replaced by a new file. The tokens' expiry is their
+// `exp` claim.
+type TokenFileProvider struct {
+	path    string
+	options TokenOptions
+
+	lock    sync.Mutex
+	modTime time.Time
+	size    int64
+	token   kafka.OAuthBearerToken
+}
+
+// NewTokenFileProvider returns a new TokenFileProvider.
+func NewTokenFileProvider(config TokenFileConfig) (*TokenFileProvider, error) {
+	if config.Path == "" {
+		return nil, invalidConfig("Path is required")
+	}
+
+	return &TokenFileProvider{
+		path:    config.Path,
+		options: config.TokenOptions,
+	}, nil
+}
+
+// Token returns the token of the file, re-reading it if it changed,
+// implementing kafka.OAuthBearerTokenProvider.
+func (p *TokenFileProvider) Token(ctx context.Context, config string) (kafka.OAuthBearerToken, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return kafka.OAuthBearerToken{}, err
+	}
+
+	if p.token.TokenValue != "" &&
+		info.ModTime().Equal(p.modTime) && info.Size() == p.size {
+		return p.token, nil
+	}
+
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return kafka.OAuthBearerToken{}, err
+	}
+
+	accessToken := strings.TrimSpace(string(data))
+	if _, err = jwtClaims(accessToken); err != nil {
+		return kafka.OAuthBearerToken{}, fmt.Errorf("%s: %w", p.path, err)
+	}
+
+	token, err := newToken(accessToken, time.Time{}, p.options, "")
+	if err != nil {
+		return kafka.OAuthBearerToken{}, fmt.Errorf("%s: %w", p.path, err)
+	}
+
+	p.token = token
+	p.modTime = info.ModTime()
+	p.size = info.Size()
+
+	return token, nil
+}
@@ -17,6 +17,7 @@
 package kafka
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 )
@@ -136,9 +137,31 @@ func OffsetTail(relativeOffset Offset) Offset {
 // Duplicate Topic+Partitions are not supported.
 // Per-partition errors may be returned in the `.Error` field.
 func offsetsForTimes(H Handle, times []TopicPartition, timeoutMs int) (offsets []TopicPartition, err error) {
+	return offsetsForTimes0(H, times, C.int(timeoutMs))
+}
+
+// offsetsForTimesContext looks up offsets by timestamp for the given partitions,
+// returning ctx.Err() as soon as ctx is done.
+func offsetsForTimesContext(ctx context.Context, H Handle, times []TopicPartition) (offsets []TopicPartition, err error) {
+	var result []TopicPartition
+	err = H.gethandle().callContext(ctx, func(cTimeoutMs C.int) error {
+		var cerr error
+		result, cerr = offsetsForTimes0(H, times, cTimeoutMs)
+		return cerr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// offsetsForTimes0 looks up offsets by timestamp for the given partitions,
+// blocking for at most cTimeoutMs.
+func offsetsForTimes0(H Handle, times []TopicPartition, cTimeoutMs C.int) (offsets []TopicPartition, err error) {
 	cparts := newCPartsFromTopicPartitions(times)
 	defer C.rd_kafka_topic_partition_list_destroy(cparts)
-	cerr := C.rd_kafka_offsets_for_times(H.gethandle().rk, cparts, C.int(timeoutMs))
+	cerr := C.rd_kafka_offsets_for_times(H.gethandle().rk, cparts, cTimeoutMs)
 	if cerr != C.RD_KAFKA_RESP_ERR_NO_ERROR {
 		return nil, newError(cerr)
 	}
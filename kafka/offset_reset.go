@@ -0,0 +1,471 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kafka
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OffsetResetMode is the strategy of a consumer group offset reset,
+// as the options of `kafka-consumer-groups --reset-offsets`.
+type OffsetResetMode int
+
+const (
+	// OffsetResetToEarliest resets to the earliest offset (--to-earliest).
+	OffsetResetToEarliest OffsetResetMode = iota
+	// OffsetResetToLatest resets to the latest offset (--to-latest).
+	OffsetResetToLatest
+	// OffsetResetToDatetime resets to the first offset with a timestamp at
+	// or after OffsetResetSpec.Datetime (--to-datetime).
+	OffsetResetToDatetime
+	// OffsetResetByDuration resets to the first offset with a timestamp at
+	// or after OffsetResetSpec.Duration ago (--by-duration).
+	OffsetResetByDuration
+	// OffsetResetShiftBy shifts the committed offset by
+	// OffsetResetSpec.Shift (--shift-by).
+	OffsetResetShiftBy
+	// OffsetResetToOffset resets to OffsetResetSpec.Offset (--to-offset).
+	OffsetResetToOffset
+	// OffsetResetFromFile resets each partition of OffsetResetSpec.Offsets
+	// to its offset (--from-file), see ReadOffsetResetCSV().
+	OffsetResetFromFile
+)
+
+// String returns the human-readable representation of an OffsetResetMode
+func (mode OffsetResetMode) String() string {
+	switch mode {
+	case OffsetResetToEarliest:
+		return "to-earliest"
+	case OffsetResetToLatest:
+		return "to-latest"
+	case OffsetResetToDatetime:
+		return "to-datetime"
+	case OffsetResetByDuration:
+		return "by-duration"
+	case OffsetResetShiftBy:
+		return "shift-by"
+	case OffsetResetToOffset:
+		return "to-offset"
+	case OffsetResetFromFile:
+		return "from-file"
+	default:
+		return fmt.Sprintf("OffsetResetMode(%d)", int(mode))
+	}
+}
+
+// OffsetResetSpec specifies a consumer group offset reset.
+type OffsetResetSpec struct {
+	// Mode is the reset strategy.
+	Mode OffsetResetMode
+	// Datetime of OffsetResetToDatetime.
+	Datetime time.Time
+	// Duration of OffsetResetByDuration.
+	Duration time.Duration
+	// Shift of OffsetResetShiftBy, negative to rewind.
+	Shift int64
+	// Offset of OffsetResetToOffset.
+	Offset Offset
+	// Offsets of OffsetResetFromFile, which also selects the partitions.
+	Offsets []TopicPartition
+}
+
+// OffsetResetPartition is the planned offset reset of a partition.
+type OffsetResetPartition struct {
+	Topic     string
+	Partition int32
+	// CurrentOffset is the group's committed offset, OffsetInvalid if none.
+	CurrentOffset Offset
+	// NewOffset is the offset the group's offset is reset to.
+	NewOffset Offset
+}
+
+// OffsetResetPlan is the plan of a consumer group offset reset,
+// returned by AdminClient.PlanConsumerGroupOffsetsReset().
+type OffsetResetPlan struct {
+	Group string
+	Spec  OffsetResetSpec
+	// Partitions are the planned resets, ordered by topic and partition.
+	Partitions []OffsetResetPartition
+}
+
+// WriteCSV writes the planned offsets as CSV records
+// "topic,partition,offset", as `kafka-consumer-groups --export`.
+func (plan *OffsetResetPlan) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	for _, p := range plan.Partitions {
+		err := cw.Write([]string{p.Topic,
+			strconv.Itoa(int(p.Partition)),
+			strconv.FormatInt(int64(p.NewOffset), 10)})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadOffsetResetCSV reads the partition offsets of an
+// OffsetResetFromFile reset from CSV records "topic,partition,offset".
+func ReadOffsetResetCSV(r io.Reader) ([]TopicPartition, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 3
+	cr.TrimLeadingSpace = true
+
+	var offsets []TopicPartition
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return offsets, nil
+		}
+		if err != nil {
+			return nil, newErrorFromString(ErrInvalidArg, err.Error())
+		}
+
+		partition, err := strconv.ParseInt(strings.TrimSpace(record[1]), 10, 32)
+		if err != nil || partition < 0 {
+			return nil, newErrorFromString(ErrInvalidArg,
+				fmt.Sprintf("Invalid partition %q", record[1]))
+		}
+		offset, err := strconv.ParseInt(strings.TrimSpace(record[2]), 10, 64)
+		if err != nil || offset < 0 {
+			return nil, newErrorFromString(ErrInvalidArg,
+				fmt.Sprintf("Invalid offset %q", record[2]))
+		}
+
+		topic := strings.TrimSpace(record[0])
+		offsets = append(offsets, TopicPartition{
+			Topic:     &topic,
+			Partition: int32(partition),
+			Offset:    Offset(offset),
+		})
+	}
+}
+
+// verifyInactiveGroup returns an error unless group is Empty, or Dead
+// (has no members nor offsets).
+func (a *AdminClient) verifyInactiveGroup(ctx context.Context, group string) error {
+	res, err := a.DescribeConsumerGroups(ctx, []string{group})
+	if err != nil {
+		return err
+	}
+
+	desc := res.ConsumerGroupDescriptions[0]
+	if desc.Error.Code() != ErrNoError {
+		return desc.Error
+	}
+	if desc.State != ConsumerGroupStateEmpty && desc.State != ConsumerGroupStateDead {
+		return newErrorFromString(ErrNonEmptyGroup,
+			fmt.Sprintf("Consumer group %s is %s: offsets can only be reset for inactive groups",
+				group, desc.State))
+	}
+
+	return nil
+}
+
+// PlanConsumerGroupOffsetsReset computes, without applying it, the offset
+// reset spec of the consumer group group on partitions.
+// This is a dry-run of ExecuteConsumerGroupOffsetsReset().
+//
+// Parameters:
+//   - `ctx` - context with the maximum amount of time to block, or nil for
+//     indefinite.
+//   - `group` - the consumer group, which must be inactive (Empty).
+//   - `partitions` - the partitions to reset. A partition of PartitionAny
+//     selects all the partitions of its topic. If nil, the partitions the
+//     group has committed offsets for are reset. Ignored for
+//     OffsetResetFromFile. A partition given several times is planned
+//     once, with its first explicit entry.
+//   - `spec` - the reset strategy.
+//
+// The new offsets are bounded by the partitions' earliest and latest
+// offsets. Timestamp resets of partitions with no later records are reset
+// to the latest offset.
+//
+// Returns the plan, or an error if the group is not inactive or the
+// offsets could not be listed.
+func (a *AdminClient) PlanConsumerGroupOffsetsReset(ctx context.Context, group string,
+	partitions []TopicPartition, spec OffsetResetSpec) (*OffsetResetPlan, error) {
+	err := a.verifyClient()
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.verifyInactiveGroup(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.Mode == OffsetResetFromFile {
+		partitions = spec.Offsets
+	}
+
+	partitions, err = a.expandTopicPartitions(ctx, partitions)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := a.listCommittedOffsets(ctx, group, partitions)
+	if err != nil {
+		return nil, err
+	}
+
+	if partitions == nil {
+		// All the partitions the group has committed offsets for
+		for key := range current {
+			topic := key.topic
+			partitions = append(partitions, TopicPartition{Topic: &topic, Partition: key.partition})
+		}
+	}
+
+	earliest, err := a.listOffsetsOf(ctx, partitions, EarliestOffsetSpec)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := a.listOffsetsOf(ctx, partitions, LatestOffsetSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	var byTimestamp map[topicPartitionKey]Offset
+	switch spec.Mode {
+	case OffsetResetToDatetime:
+		byTimestamp, err = a.listOffsetsOf(ctx, partitions,
+			NewOffsetSpecForTimestamp(spec.Datetime.UnixMilli()))
+	case OffsetResetByDuration:
+		byTimestamp, err = a.listOffsetsOf(ctx, partitions,
+			NewOffsetSpecForTimestamp(time.Now().Add(-spec.Duration).UnixMilli()))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &OffsetResetPlan{Group: group, Spec: spec}
+	for _, tp := range partitions {
+		key := topicPartitionKey{*tp.Topic, tp.Partition}
+		currentOffset, found := current[key]
+		if !found {
+			currentOffset = OffsetInvalid
+		}
+
+		var newOffset Offset
+		switch spec.Mode {
+		case OffsetResetToEarliest:
+			newOffset = earliest[key]
+		case OffsetResetToLatest:
+			newOffset = latest[key]
+		case OffsetResetToDatetime, OffsetResetByDuration:
+			newOffset = byTimestamp[key]
+			if newOffset < 0 {
+				// No record at or after the timestamp
+				newOffset = latest[key]
+			}
+		case OffsetResetShiftBy:
+			if currentOffset < 0 {
+				return nil, newErrorFromString(ErrInvalidArg,
+					fmt.Sprintf("Consumer group %s has no committed offset to shift for %s [%d]",
+						group, key.topic, key.partition))
+			}
+			newOffset = currentOffset + Offset(spec.Shift)
+		case OffsetResetToOffset:
+			newOffset = spec.Offset
+		case OffsetResetFromFile:
+			newOffset = tp.Offset
+		default:
+			return nil, newErrorFromString(ErrInvalidArg,
+				fmt.Sprintf("Unknown offset reset mode %s", spec.Mode))
+		}
+
+		if newOffset < earliest[key] {
+			newOffset = earliest[key]
+		} else if newOffset > latest[key] {
+			newOffset = latest[key]
+		}
+
+		plan.Partitions = append(plan.Partitions, OffsetResetPartition{
+			Topic:         key.topic,
+			Partition:     key.partition,
+			CurrentOffset: currentOffset,
+			NewOffset:     newOffset,
+		})
+	}
+
+	sort.Slice(plan.Partitions, func(i, j int) bool {
+		x, y := plan.Partitions[i], plan.Partitions[j]
+		return x.Topic < y.Topic || (x.Topic == y.Topic && x.Partition < y.Partition)
+	})
+
+	return plan, nil
+}
+
+// ExecuteConsumerGroupOffsetsReset applies the offset reset plan, computed
+// by PlanConsumerGroupOffsetsReset(), with AlterConsumerGroupOffsets().
+//
+// Parameters:
+//   - `ctx` - context with the maximum amount of time to block, or nil for
+//     indefinite.
+//   - `plan` - the offset reset plan.
+//
+// Returns the altered partitions, whose individual errors should be
+// checked, or an error if the group is no longer inactive or the offsets
+// could not be altered.
+func (a *AdminClient) ExecuteConsumerGroupOffsetsReset(ctx context.Context,
+	plan *OffsetResetPlan) ([]TopicPartition, error) {
+	err := a.verifyClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if plan == nil || len(plan.Partitions) == 0 {
+		return nil, newErrorFromString(ErrInvalidArg, "Expected a non-empty plan")
+	}
+
+	// The group may have become active since the plan was computed.
+	err = a.verifyInactiveGroup(ctx, plan.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	partitions := make([]TopicPartition, len(plan.Partitions))
+	for i, p := range plan.Partitions {
+		topic := p.Topic
+		partitions[i] = TopicPartition{Topic: &topic, Partition: p.Partition, Offset: p.NewOffset}
+	}
+
+	res, err := a.AlterConsumerGroupOffsets(ctx, []ConsumerGroupTopicPartitions{
+		{Group: plan.Group, Partitions: partitions}})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.ConsumerGroupsTopicPartitions[0].Partitions, nil
+}
+
+// expandTopicPartitions replaces the PartitionAny partitions of partitions
+// with all the partitions of their topic, and removes the duplicate
+// partitions, keeping the first explicit one.
+func (a *AdminClient) expandTopicPartitions(ctx context.Context, partitions []TopicPartition) ([]TopicPartition, error) {
+	if partitions == nil {
+		return nil, nil
+	}
+
+	var topics []string
+	expanded := make([]TopicPartition, 0, len(partitions))
+	seenTopics := make(map[string]bool)
+	seen := make(map[topicPartitionKey]bool)
+	for _, tp := range partitions {
+		if tp.Topic == nil {
+			return nil, newErrorFromString(ErrInvalidArg, "Partitions must have a topic")
+		}
+		if tp.Partition == PartitionAny {
+			if !seenTopics[*tp.Topic] {
+				seenTopics[*tp.Topic] = true
+				topics = append(topics, *tp.Topic)
+			}
+			continue
+		}
+		key := topicPartitionKey{*tp.Topic, tp.Partition}
+		if !seen[key] {
+			seen[key] = true
+			expanded = append(expanded, tp)
+		}
+	}
+
+	if len(topics) == 0 {
+		return expanded, nil
+	}
+
+	res, err := a.DescribeTopics(ctx, NewTopicCollectionOfTopicNames(topics))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, desc := range res.TopicDescriptions {
+		if desc.Error.Code() != ErrNoError {
+			return nil, desc.Error
+		}
+		for _, p := range desc.Partitions {
+			key := topicPartitionKey{desc.Name, int32(p.Partition)}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			topic := desc.Name
+			expanded = append(expanded, TopicPartition{Topic: &topic, Partition: int32(p.Partition)})
+		}
+	}
+
+	return expanded, nil
+}
+
+// listCommittedOffsets returns the committed offsets of group on partitions,
+// or on all partitions if nil.
+func (a *AdminClient) listCommittedOffsets(ctx context.Context, group string,
+	partitions []TopicPartition) (map[topicPartitionKey]Offset, error) {
+	res, err := a.ListConsumerGroupOffsets(ctx,
+		[]ConsumerGroupTopicPartitions{{Group: group, Partitions: partitions}})
+	if err != nil {
+		return nil, err
+	}
+
+	offsets := make(map[topicPartitionKey]Offset)
+	for _, tp := range res.ConsumerGroupsTopicPartitions[0].Partitions {
+		if tp.Error != nil {
+			return nil, tp.Error
+		}
+		if tp.Offset >= 0 {
+			offsets[topicPartitionKey{*tp.Topic, tp.Partition}] = tp.Offset
+		}
+	}
+
+	return offsets, nil
+}
+
+// listOffsetsOf returns the offsets of partitions matching spec.
+func (a *AdminClient) listOffsetsOf(ctx context.Context, partitions []TopicPartition,
+	spec OffsetSpec) (map[topicPartitionKey]Offset, error) {
+	offsets := make(map[topicPartitionKey]Offset)
+	if len(partitions) == 0 {
+		return offsets, nil
+	}
+
+	specs := make(map[TopicPartition]OffsetSpec, len(partitions))
+	for _, tp := range partitions {
+		topic := *tp.Topic
+		specs[TopicPartition{Topic: &topic, Partition: tp.Partition}] = spec
+	}
+
+	res, err := a.ListOffsets(ctx, specs)
+	if err != nil {
+		return nil, err
+	}
+
+	for tp, info := range res.ResultInfos {
+		if info.Error.Code() != ErrNoError {
+			return nil, info.Error
+		}
+		offsets[topicPartitionKey{*tp.Topic, tp.Partition}] = info.Offset
+	}
+
+	return offsets, nil
+}
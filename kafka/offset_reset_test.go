@@ -0,0 +1,75 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kafka
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestOffsetResetCSV tests reading and writing offset reset CSV files
+func TestOffsetResetCSV(t *testing.T) {
+	offsets, err := ReadOffsetResetCSV(strings.NewReader("topic1,0,10\ntopic1, 1, 20\ntopic2,0,0\n"))
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	expected := []TopicPartition{
+		{Partition: 0, Offset: 10},
+		{Partition: 1, Offset: 20},
+		{Partition: 0, Offset: 0},
+	}
+	expectedTopics := []string{"topic1", "topic1", "topic2"}
+	if len(offsets) != len(expected) {
+		t.Fatalf("Expected %d offsets, got %v", len(expected), offsets)
+	}
+	for i, tp := range offsets {
+		if *tp.Topic != expectedTopics[i] || tp.Partition != expected[i].Partition ||
+			tp.Offset != expected[i].Offset {
+			t.Errorf("Expected %s [%d]@%v, got %v",
+				expectedTopics[i], expected[i].Partition, expected[i].Offset, tp)
+		}
+	}
+
+	plan := OffsetResetPlan{Group: "group"}
+	for _, tp := range offsets {
+		plan.Partitions = append(plan.Partitions, OffsetResetPartition{
+			Topic: *tp.Topic, Partition: tp.Partition,
+			CurrentOffset: OffsetInvalid, NewOffset: tp.Offset})
+	}
+	var buf bytes.Buffer
+	err = plan.WriteCSV(&buf)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	if buf.String() != "topic1,0,10\ntopic1,1,20\ntopic2,0,0\n" {
+		t.Errorf("Unexpected CSV:\n%s", buf.String())
+	}
+
+	for _, invalid := range []string{
+		"topic1,0\n",
+		"topic1,x,10\n",
+		"topic1,-1,10\n",
+		"topic1,0,-2\n",
+	} {
+		_, err = ReadOffsetResetCSV(strings.NewReader(invalid))
+		if err == nil || err.(Error).Code() != ErrInvalidArg {
+			t.Errorf("Expected ErrInvalidArg for %q, got %v", invalid, err)
+		}
+	}
+}
@@ -0,0 +1,519 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package parallel provides a concurrent message processing engine on top of
+// kafka.Consumer that preserves at-least-once semantics.
+//
+// Messages are fanned out to a fixed number of workers while preserving
+// the processing order of messages with the same key (or of the same
+// partition). Since messages then complete out of order, the Processor
+// tracks the in-flight offsets of each partition and only stores the
+// offset following the highest contiguous completed message, so that
+// committed offsets never skip unprocessed messages.
+package parallel
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// Handler processes a single message.
+//
+// ctx is cancelled when the Processor stops or when the message's partition
+// is revoked with RevokeAbandon.
+// A non-nil error stops the Processor, and neither the message's offset
+// nor any later offset of its partition is stored.
+type Handler func(ctx context.Context, msg *kafka.Message) error
+
+// Ordering defines which messages are processed in order.
+type Ordering int
+
+const (
+	// KeyOrdering processes messages with the same key in order.
+	// Messages without a key are processed in partition order.
+	KeyOrdering Ordering = iota
+	// PartitionOrdering processes all messages of a partition in order.
+	PartitionOrdering
+)
+
+// RevokeMode defines how in-flight messages of revoked partitions are handled.
+type RevokeMode int
+
+const (
+	// RevokeDrain waits for the in-flight messages of revoked partitions to
+	// be processed and commits their offsets before the partitions are
+	// unassigned.
+	RevokeDrain RevokeMode = iota
+	// RevokeAbandon cancels the context of the in-flight messages of revoked
+	// partitions and skips the messages not yet being processed.
+	// Their offsets are not stored and the messages will be
+	// re-consumed by the partitions' new owner.
+	RevokeAbandon
+)
+
+// Config holds the Processor configuration.
+type Config struct {
+	// Workers is the number of concurrent workers (default 8).
+	Workers int
+	// Ordering defines which messages are processed in order (default KeyOrdering).
+	Ordering Ordering
+	// MaxInFlight is the maximum number of consumed messages
+	// not yet stored per partition (default 1000).
+	// A partition is paused when its window is full and resumed when
+	// it is half empty.
+	MaxInFlight int
+	// OnRevoke defines how in-flight messages of revoked
+	// partitions are handled (default RevokeDrain).
+	// Lost partitions are always abandoned.
+	OnRevoke RevokeMode
+	// PollTimeout is the consumer poll timeout (default 100ms).
+	PollTimeout time.Duration
+	// ErrorCb is called with non-fatal consumer errors, if set.
+	ErrorCb func(err error)
+}
+
+// pendingOffset is a consumed message whose offset is not yet stored.
+type pendingOffset struct {
+	offset      kafka.Offset
+	leaderEpoch *int32
+	done        bool
+}
+
+// partitionState tracks the in-flight offsets of an assigned partition.
+type partitionState struct {
+	tp     kafka.TopicPartition
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// pending offsets in consumption order
+	pending []*pendingOffset
+	// inFlight is the number of dispatched messages not yet processed
+	inFlight int
+	// stored is the last stored offset, if any
+	stored *kafka.TopicPartition
+
+	paused  bool
+	revoked bool
+}
+
+// task is a message dispatched to a worker.
+type task struct {
+	msg *kafka.Message
+	ps  *partitionState
+	po  *pendingOffset
+}
+
+// partitionKey identifies a partition in Processor.partitions
+type partitionKey struct {
+	topic     string
+	partition int32
+}
+
+// Processor consumes messages from a kafka.Consumer and processes them
+// concurrently with a Handler.
+//
+// The consumer must be configured with `enable.auto.offset.store=false`:
+// the Processor stores the offsets of processed messages, which are
+// then committed by the consumer's auto commit, or explicitly by the
+// application.
+type Processor struct {
+	c       *kafka.Consumer
+	handler Handler
+	config  Config
+
+	lock       sync.Mutex
+	cond       *sync.Cond
+	partitions map[partitionKey]*partitionState
+
+	workers []chan *task
+	started bool
+	ctx     context.Context
+	cancel  context.CancelFunc
+	err     error
+}
+
+// NewProcessor creates a new Processor for consumer c, processing messages
+// with handler.
+func NewProcessor(c *kafka.Consumer, handler Handler, config Config) (*Processor, error) {
+	if c == nil || handler == nil {
+		return nil, kafka.NewError(kafka.ErrInvalidArg,
+			"Consumer and handler are required", false)
+	}
+
+	if config.Workers < 0 || config.MaxInFlight < 0 || config.PollTimeout < 0 {
+		return nil, kafka.NewError(kafka.ErrInvalidArg,
+			"Workers, MaxInFlight and PollTimeout must not be negative", false)
+	}
+	if config.Workers == 0 {
+		config.Workers = 8
+	}
+	if config.MaxInFlight == 0 {
+		config.MaxInFlight = 1000
+	}
+	if config.PollTimeout == 0 {
+		config.PollTimeout = 100 * time.Millisecond
+	}
+
+	p := &Processor{
+		c:          c,
+		handler:    handler,
+		config:     config,
+		partitions: make(map[partitionKey]*partitionState),
+	}
+	p.cond = sync.NewCond(&p.lock)
+
+	return p, nil
+}
+
+// String returns a human readable name for a Processor instance
+func (p *Processor) String() string {
+	return fmt.Sprintf("Processor(%s)", p.c)
+}
+
+// Run subscribes the consumer to topics and processes messages until ctx is
+// done, a handler fails, or a fatal consumer error is raised.
+//
+// The consumer's rebalance callback is used by the Processor; revoked
+// partitions are handled according to Config.OnRevoke.
+// Run waits for the messages being processed to complete before returning,
+// but does not close the consumer.
+//
+// Run may only be called once: the messages skipped when it returns are
+// not processed by another Run. Close the consumer, or seek it to the
+// committed offsets, and create a new Processor instead.
+//
+// Returns the handler's error, the fatal consumer error or ctx.Err(),
+// or ErrState if Run was already called.
+func (p *Processor) Run(ctx context.Context, topics []string) error {
+	p.lock.Lock()
+	if p.started {
+		p.lock.Unlock()
+		return kafka.NewError(kafka.ErrState,
+			"Run may only be called once per Processor", false)
+	}
+	p.started = true
+	p.ctx, p.cancel = context.WithCancel(ctx)
+	p.lock.Unlock()
+	defer p.cancel()
+
+	err := p.c.SubscribeTopics(topics, p.rebalance)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	p.workers = make([]chan *task, p.config.Workers)
+	for i := range p.workers {
+		p.workers[i] = make(chan *task, p.config.MaxInFlight)
+		wg.Add(1)
+		go func(tasks chan *task) {
+			defer wg.Done()
+			p.worker(tasks)
+		}(p.workers[i])
+	}
+
+	pollTimeoutMs := int(p.config.PollTimeout / time.Millisecond)
+
+	for p.ctx.Err() == nil {
+		p.resumePartitions()
+
+		switch e := p.c.Poll(pollTimeoutMs).(type) {
+		case *kafka.Message:
+			if e.TopicPartition.Error != nil {
+				p.onError(e.TopicPartition.Error)
+				continue
+			}
+			p.dispatch(e)
+
+		case kafka.Error:
+			if e.IsFatal() {
+				p.fail(e)
+			} else {
+				p.onError(e)
+			}
+		}
+	}
+
+	// Workers skip the remaining tasks once the Processor is stopped.
+	for _, tasks := range p.workers {
+		close(tasks)
+	}
+	wg.Wait()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	return ctx.Err()
+}
+
+// dispatch tracks msg's offset and sends it to its worker, pausing the
+// partition if its in-flight window is full.
+func (p *Processor) dispatch(msg *kafka.Message) {
+	p.lock.Lock()
+	ps := p.partitionState(msg.TopicPartition)
+	po := &pendingOffset{
+		offset:      msg.TopicPartition.Offset,
+		leaderEpoch: msg.TopicPartition.LeaderEpoch,
+	}
+	ps.pending = append(ps.pending, po)
+	ps.inFlight++
+
+	if len(ps.pending) >= p.config.MaxInFlight && !ps.paused {
+		err := p.c.Pause([]kafka.TopicPartition{ps.tp})
+		if err != nil {
+			p.onError(err)
+		} else {
+			ps.paused = true
+		}
+	}
+	p.lock.Unlock()
+
+	p.workers[p.workerIndex(msg)] <- &task{msg: msg, ps: ps, po: po}
+}
+
+// workerIndex returns the index of the worker processing msg,
+// such that messages to be processed in order share the same worker.
+func (p *Processor) workerIndex(msg *kafka.Message) int {
+	h := fnv.New32a()
+	if p.config.Ordering == KeyOrdering && msg.Key != nil {
+		h.Write(msg.Key)
+	} else {
+		h.Write([]byte(*msg.TopicPartition.Topic))
+		h.Write([]byte{
+			byte(msg.TopicPartition.Partition >> 24),
+			byte(msg.TopicPartition.Partition >> 16),
+			byte(msg.TopicPartition.Partition >> 8),
+			byte(msg.TopicPartition.Partition)})
+	}
+	return int(h.Sum32() % uint32(len(p.workers)))
+}
+
+// worker processes the tasks sent to it in order.
+func (p *Processor) worker(tasks chan *task) {
+	for t := range tasks {
+		p.lock.Lock()
+		skip := t.ps.revoked || p.ctx.Err() != nil
+		p.lock.Unlock()
+
+		var err error
+		if !skip {
+			err = p.handler(t.ps.ctx, t.msg)
+		}
+
+		p.complete(t, skip, err)
+	}
+}
+
+// complete marks task t as processed and stores the partition's
+// highest contiguous completed offset.
+func (p *Processor) complete(t *task, skipped bool, err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	defer p.cond.Broadcast()
+
+	ps := t.ps
+	ps.inFlight--
+
+	if err != nil {
+		p.failLocked(err)
+		return
+	}
+	if skipped {
+		return
+	}
+
+	t.po.done = true
+
+	var last *pendingOffset
+	for len(ps.pending) > 0 && ps.pending[0].done {
+		last = ps.pending[0]
+		ps.pending[0] = nil
+		ps.pending = ps.pending[1:]
+	}
+
+	if last == nil || ps.revoked {
+		return
+	}
+
+	stored := kafka.TopicPartition{
+		Topic:       ps.tp.Topic,
+		Partition:   ps.tp.Partition,
+		Offset:      last.offset + 1,
+		LeaderEpoch: last.leaderEpoch,
+	}
+	_, err = p.c.StoreOffsets([]kafka.TopicPartition{stored})
+	if err != nil {
+		p.onError(err)
+		return
+	}
+	ps.stored = &stored
+}
+
+// resumePartitions resumes the paused partitions whose in-flight
+// window is at most half full.
+func (p *Processor) resumePartitions() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	var resume []kafka.TopicPartition
+	var resumed []*partitionState
+	for _, ps := range p.partitions {
+		if ps.paused && len(ps.pending) <= p.config.MaxInFlight/2 {
+			resume = append(resume, ps.tp)
+			resumed = append(resumed, ps)
+		}
+	}
+
+	if len(resume) == 0 {
+		return
+	}
+
+	err := p.c.Resume(resume)
+	if err != nil {
+		p.onError(err)
+		return
+	}
+	for _, ps := range resumed {
+		ps.paused = false
+	}
+}
+
+// partitionState returns the state of partition tp, creating it if needed.
+// Must be called with lock held.
+func (p *Processor) partitionState(tp kafka.TopicPartition) *partitionState {
+	key := partitionKey{*tp.Topic, tp.Partition}
+	ps, found := p.partitions[key]
+	if !found {
+		ps = &partitionState{
+			tp: kafka.TopicPartition{Topic: tp.Topic, Partition: tp.Partition},
+		}
+		ps.ctx, ps.cancel = context.WithCancel(p.ctx)
+		p.partitions[key] = ps
+	}
+	return ps
+}
+
+// rebalance is the consumer's rebalance callback.
+func (p *Processor) rebalance(c *kafka.Consumer, ev kafka.Event) error {
+	switch e := ev.(type) {
+	case kafka.AssignedPartitions:
+		p.lock.Lock()
+		for _, tp := range e.Partitions {
+			p.partitionState(tp)
+		}
+		p.lock.Unlock()
+
+	case kafka.RevokedPartitions:
+		if p.config.OnRevoke == RevokeDrain && !c.AssignmentLost() {
+			p.drain(e.Partitions)
+		}
+		p.revoke(e.Partitions)
+	}
+
+	// Let the consumer perform the (incremental) assign or unassign.
+	return nil
+}
+
+// drain waits for the in-flight messages of partitions to be
+// processed and commits their stored offsets.
+func (p *Processor) drain(partitions []kafka.TopicPartition) {
+	p.lock.Lock()
+	var states []*partitionState
+	for _, tp := range partitions {
+		if ps, found := p.partitions[partitionKey{*tp.Topic, tp.Partition}]; found {
+			states = append(states, ps)
+		}
+	}
+
+	for _, ps := range states {
+		for ps.inFlight > 0 {
+			p.cond.Wait()
+		}
+	}
+
+	var offsets []kafka.TopicPartition
+	for _, ps := range states {
+		if ps.stored != nil {
+			offsets = append(offsets, *ps.stored)
+		}
+	}
+	p.lock.Unlock()
+
+	if len(offsets) > 0 {
+		_, err := p.c.CommitOffsets(offsets)
+		if err != nil {
+			p.onError(err)
+		}
+	}
+}
+
+// revoke abandons the in-flight messages of partitions and
+// forgets their state.
+// Paused partitions are resumed such that they are not paused if
+// assigned again.
+func (p *Processor) revoke(partitions []kafka.TopicPartition) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	var paused []kafka.TopicPartition
+	for _, tp := range partitions {
+		key := partitionKey{*tp.Topic, tp.Partition}
+		if ps, found := p.partitions[key]; found {
+			ps.revoked = true
+			ps.cancel()
+			if ps.paused {
+				paused = append(paused, ps.tp)
+			}
+			delete(p.partitions, key)
+		}
+	}
+
+	if len(paused) > 0 {
+		err := p.c.Resume(paused)
+		if err != nil {
+			p.onError(err)
+		}
+	}
+}
+
+// fail stops the Processor with err, unless already stopped by an error.
+func (p *Processor) fail(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.failLocked(err)
+}
+
+// failLocked is fail() with lock held.
+func (p *Processor) failLocked(err error) {
+	if p.err == nil {
+		p.err = err
+	}
+	p.cancel()
+}
+
+// onError reports a non-fatal error to the application's ErrorCb, if any.
+func (p *Processor) onError(err error) {
+	if p.config.ErrorCb != nil {
+		p.config.ErrorCb(err)
+	}
+}
@@ -0,0 +1,238 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parallel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testPartitionCnt = 3
+	testKeyCnt       = 5
+	testMsgCnt       = 100
+)
+
+// setupMockCluster creates a mock cluster with a topic primed with
+// testMsgCnt messages, with keys key0..key<testKeyCnt-1> and increasing
+// sequence numbers as values.
+func setupMockCluster(t *testing.T, topic string) *kafka.MockCluster {
+	mockCluster, err := kafka.NewMockCluster(1)
+	if err != nil {
+		t.Fatalf("Mock cluster creation failed: %s", err)
+	}
+
+	err = mockCluster.CreateTopic(topic, testPartitionCnt, 1)
+	if err != nil {
+		t.Fatalf("Topic creation failed: %s", err)
+	}
+
+	p, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+	})
+	if err != nil {
+		t.Fatalf("Producer creation failed: %s", err)
+	}
+	defer p.Close()
+
+	for i := 0; i < testMsgCnt; i++ {
+		err = p.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+			Key:            []byte(fmt.Sprintf("key%d", i%testKeyCnt)),
+			Value:          []byte(fmt.Sprintf("%d", i)),
+		}, nil)
+		if err != nil {
+			t.Fatalf("Produce failed: %s", err)
+		}
+	}
+
+	if p.Flush(10*1000) != 0 {
+		t.Fatalf("Flush timed out")
+	}
+
+	return mockCluster
+}
+
+func newTestConsumer(t *testing.T, mockCluster *kafka.MockCluster) *kafka.Consumer {
+	c, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":        mockCluster.BootstrapServers(),
+		"group.id":                 "processor",
+		"auto.offset.reset":        "earliest",
+		"enable.auto.commit":       false,
+		"enable.auto.offset.store": false,
+	})
+	if err != nil {
+		t.Fatalf("Consumer creation failed: %s", err)
+	}
+	return c
+}
+
+// committedCount returns the sum of the committed offsets of topic.
+func committedCount(t *testing.T, c *kafka.Consumer, topic string) int {
+	partitions := make([]kafka.TopicPartition, testPartitionCnt)
+	for i := range partitions {
+		partitions[i] = kafka.TopicPartition{Topic: &topic, Partition: int32(i)}
+	}
+
+	committed, err := c.Committed(partitions, 10*1000)
+	if err != nil {
+		t.Fatalf("Committed failed: %s", err)
+	}
+
+	cnt := 0
+	for _, tp := range committed {
+		if tp.Offset >= 0 {
+			cnt += int(tp.Offset)
+		}
+	}
+	return cnt
+}
+
+func TestNewProcessor(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewProcessor(nil, func(ctx context.Context, msg *kafka.Message) error {
+		return nil
+	}, Config{})
+	assert.Error(err, "A consumer should be required")
+
+	c, err := kafka.NewConsumer(&kafka.ConfigMap{"group.id": "processor"})
+	assert.NoError(err)
+	defer c.Close()
+
+	_, err = NewProcessor(c, nil, Config{})
+	assert.Error(err, "A handler should be required")
+
+	_, err = NewProcessor(c, func(ctx context.Context, msg *kafka.Message) error {
+		return nil
+	}, Config{Workers: -1})
+	assert.Error(err, "Negative Workers should fail")
+
+	p, err := NewProcessor(c, func(ctx context.Context, msg *kafka.Message) error {
+		return nil
+	}, Config{})
+	assert.NoError(err)
+	assert.Equal(8, p.config.Workers)
+	assert.Equal(1000, p.config.MaxInFlight)
+	assert.Equal(100*time.Millisecond, p.config.PollTimeout)
+}
+
+// TestProcessorKeyOrdering tests that messages with the same key are
+// processed in order while processing completes out of order, and that
+// the offsets of all processed messages are stored.
+func TestProcessorKeyOrdering(t *testing.T) {
+	assert := assert.New(t)
+
+	topic := "processor"
+	mockCluster := setupMockCluster(t, topic)
+	defer mockCluster.Close()
+
+	c := newTestConsumer(t, mockCluster)
+
+	var lock sync.Mutex
+	processed := make(map[string][]int)
+	cnt := 0
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	p, err := NewProcessor(c, func(ctx context.Context, msg *kafka.Message) error {
+		// Complete out of order
+		time.Sleep(time.Duration(rand.Intn(5)) * time.Millisecond)
+
+		var seq int
+		fmt.Sscanf(string(msg.Value), "%d", &seq)
+
+		lock.Lock()
+		defer lock.Unlock()
+		processed[string(msg.Key)] = append(processed[string(msg.Key)], seq)
+		cnt++
+		if cnt == testMsgCnt {
+			cancel()
+		}
+		return nil
+	}, Config{Workers: 4, MaxInFlight: 10})
+	assert.NoError(err)
+
+	err = p.Run(ctx, []string{topic})
+	assert.Equal(context.Canceled, err)
+
+	lock.Lock()
+	assert.Equal(testMsgCnt, cnt, "All messages should be processed")
+	for key, seqs := range processed {
+		for i := 1; i < len(seqs); i++ {
+			assert.Less(seqs[i-1], seqs[i], "Messages with key %s should be processed in order", key)
+		}
+	}
+	lock.Unlock()
+
+	_, err = c.Commit()
+	assert.NoError(err, "Stored offsets should be committed")
+	assert.Equal(testMsgCnt, committedCount(t, c, topic),
+		"The offsets of all processed messages should be committed")
+
+	assert.NoError(c.Close())
+}
+
+// TestProcessorHandlerError tests that a failed message stops the
+// Processor and that its offset is not committed.
+func TestProcessorHandlerError(t *testing.T) {
+	assert := assert.New(t)
+
+	topic := "processor"
+	mockCluster := setupMockCluster(t, topic)
+	defer mockCluster.Close()
+
+	c := newTestConsumer(t, mockCluster)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	handlerErr := errors.New("handler failed")
+	p, err := NewProcessor(c, func(ctx context.Context, msg *kafka.Message) error {
+		if string(msg.Value) == "10" {
+			return handlerErr
+		}
+		return nil
+	}, Config{Workers: 4, OnRevoke: RevokeAbandon})
+	assert.NoError(err)
+
+	err = p.Run(ctx, []string{topic})
+	assert.Equal(handlerErr, err)
+
+	err = p.Run(ctx, []string{topic})
+	if assert.Error(err, "Run should only be called once") {
+		assert.Equal(kafka.ErrState, err.(kafka.Error).Code())
+	}
+
+	_, err = c.Commit()
+	if err != nil {
+		assert.Equal(kafka.ErrNoOffset, err.(kafka.Error).Code())
+	}
+	assert.Less(committedCount(t, c, topic), testMsgCnt,
+		"The failed message's offset should not be committed")
+
+	assert.NoError(c.Close())
+}
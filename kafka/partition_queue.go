@@ -0,0 +1,274 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kafka
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+)
+
+/*
+#include <stdlib.h>
+#include "select_rdkafka.h"
+#include "glue_rdkafka.h"
+*/
+import "C"
+
+// PartitionQueue provides the ordered stream of messages and
+// partition-specific events of a single assigned partition.
+//
+// Partition queues are enabled with the `go.partition.queues.enable`
+// consumer configuration property, in which case a queue is created for
+// each partition assigned through a rebalance, before the application's
+// rebalance callback is called, and torn down when the partition is revoked
+// (or lost), before the application's rebalance callback is called and
+// before the partition is unassigned.
+// Messages for partitions with a queue are only returned by the
+// PartitionQueue and not by Consumer.Poll() or the Events() channel.
+//
+// A typical application runs one goroutine per assigned partition,
+// retrieving its PartitionQueue with Consumer.PartitionQueue() from the
+// AssignedPartitions rebalance callback and stopping when Done() is closed.
+type PartitionQueue struct {
+	c    *Consumer
+	rkqu *C.rd_kafka_queue_t
+	tp   TopicPartition
+	done chan struct{}
+
+	// revoked is set when the partition is revoked and the
+	// queue must no longer be polled.
+	revoked uint32
+	// pollLock is held (shared) by pollers and (exclusively)
+	// by destroy() to make sure no poller remains on rkqu.
+	pollLock sync.RWMutex
+}
+
+// String returns a human readable representation of a PartitionQueue
+func (q *PartitionQueue) String() string {
+	return fmt.Sprintf("PartitionQueue(%s)", q.tp)
+}
+
+// TopicPartition returns the topic and partition of this queue.
+func (q *PartitionQueue) TopicPartition() TopicPartition {
+	return q.tp
+}
+
+// Done returns a channel that is closed when the partition has been revoked
+// and the queue is being torn down.
+// Goroutines consuming from the queue must stop consuming and
+// return promptly when Done() is closed.
+func (q *PartitionQueue) Done() <-chan struct{} {
+	return q.done
+}
+
+// IsRevoked returns true if the partition has been revoked and
+// the queue may no longer be polled.
+func (q *PartitionQueue) IsRevoked() bool {
+	return atomic.LoadUint32(&q.revoked) == 1
+}
+
+// Poll the partition queue for messages or partition-specific events.
+//
+// Will block for at most timeoutMs milliseconds, or until the partition
+// is revoked.
+//
+// The following callbacks may be triggered:
+//
+//	none
+//
+// Returns nil on timeout or when the partition has been revoked, else an
+// Event: *Message, PartitionEOF or Error.
+func (q *PartitionQueue) Poll(timeoutMs int) (event Event) {
+	q.pollLock.RLock()
+	defer q.pollLock.RUnlock()
+
+	if q.IsRevoked() {
+		return nil
+	}
+
+	return q.c.handle.queueEventPoll(q.rkqu, timeoutMs)
+}
+
+// ReadMessage polls the partition queue for a message.
+//
+// This is a convenience API that wraps Poll() and only returns
+// messages or errors. All other event types are discarded.
+//
+// The call will block for at most `timeout` waiting for
+// a new message or error. `timeout` may be set to -1 for
+// indefinite wait.
+//
+// Timeout is returned as (nil, err) where `err.(kafka.Error).Code() == kafka.ErrTimedOut`.
+// Revocation of the partition is returned as (nil, err) where
+// `err.(kafka.Error).Code() == kafka.ErrState`.
+//
+// Messages are returned as (msg, nil),
+// while general errors are returned as (nil, err),
+// and partition-specific errors are returned as (msg, err) where
+// msg.TopicPartition provides partition-specific information (such as topic, partition and offset).
+func (q *PartitionQueue) ReadMessage(timeout time.Duration) (*Message, error) {
+	var absTimeout time.Time
+	var timeoutMs int
+
+	if timeout > 0 {
+		absTimeout = time.Now().Add(timeout)
+		timeoutMs = (int)(timeout.Seconds() * 1000.0)
+	} else {
+		timeoutMs = (int)(timeout)
+	}
+
+	for {
+		if q.IsRevoked() {
+			return nil, newErrorFromString(ErrState,
+				fmt.Sprintf("%s has been revoked", q))
+		}
+
+		ev := q.Poll(timeoutMs)
+
+		switch e := ev.(type) {
+		case *Message:
+			if e.TopicPartition.Error != nil {
+				return e, e.TopicPartition.Error
+			}
+			return e, nil
+		case Error:
+			return nil, e
+		default:
+			// Ignore other event types
+		}
+
+		if timeout > 0 {
+			// Calculate remaining time
+			timeoutMs = int(math.Max(0.0, absTimeout.Sub(time.Now()).Seconds()*1000.0))
+		}
+
+		if timeoutMs == 0 && ev == nil {
+			return nil, newError(C.RD_KAFKA_RESP_ERR__TIMED_OUT)
+		}
+	}
+}
+
+// destroy marks the queue as revoked, wakes up and waits for any
+// ongoing Poll() calls and then releases the underlying C queue.
+func (q *PartitionQueue) destroy() {
+	atomic.StoreUint32(&q.revoked, 1)
+	close(q.done)
+
+	// Wake up blocked pollers until they have all returned:
+	// a queue yield only wakes up a single poller.
+	for !q.pollLock.TryLock() {
+		C.rd_kafka_queue_yield(q.rkqu)
+		time.Sleep(time.Millisecond)
+	}
+	defer q.pollLock.Unlock()
+
+	C.rd_kafka_queue_destroy(q.rkqu)
+	q.rkqu = nil
+}
+
+// PartitionQueue returns the queue of the assigned partition tp.
+//
+// Requires `go.partition.queues.enable=true`.
+// Returns an ErrUnknownPartition error if no queue exists for the
+// partition, i.e., the partition is not currently assigned.
+func (c *Consumer) PartitionQueue(tp TopicPartition) (*PartitionQueue, error) {
+	err := c.verifyClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.partitionQueuesEnable {
+		return nil, newErrorFromString(ErrInvalidArg,
+			"Partition queues are not enabled: set go.partition.queues.enable=true")
+	}
+
+	if tp.Topic == nil {
+		return nil, newErrorFromString(ErrInvalidArg, "Topic must be set")
+	}
+
+	c.partitionQueuesLock.Lock()
+	defer c.partitionQueuesLock.Unlock()
+
+	q, found := c.partitionQueues[topicPartitionKey{*tp.Topic, tp.Partition}]
+	if !found {
+		return nil, newErrorFromString(ErrUnknownPartition,
+			fmt.Sprintf("No queue for unassigned partition %s", tp))
+	}
+
+	return q, nil
+}
+
+// openPartitionQueues creates the queues for the partitions in cparts,
+// disabling their forwarding to the consumer queue.
+func (c *Consumer) openPartitionQueues(cparts *C.rd_kafka_topic_partition_list_t) {
+	c.partitionQueuesLock.Lock()
+	defer c.partitionQueuesLock.Unlock()
+
+	for _, tp := range newTopicPartitionsFromCparts(cparts) {
+		key := topicPartitionKey{*tp.Topic, tp.Partition}
+		if _, found := c.partitionQueues[key]; found {
+			continue
+		}
+
+		cTopic := C.CString(*tp.Topic)
+		rkqu := C.rd_kafka_queue_get_partition(c.handle.rk, cTopic, C.int32_t(tp.Partition))
+		C.free(unsafe.Pointer(cTopic))
+		if rkqu == nil {
+			continue
+		}
+
+		// Stop forwarding the partition's messages to the consumer queue.
+		C.rd_kafka_queue_forward(rkqu, nil)
+
+		c.partitionQueues[key] = &PartitionQueue{
+			c:    c,
+			rkqu: rkqu,
+			tp:   TopicPartition{Topic: tp.Topic, Partition: tp.Partition},
+			done: make(chan struct{}),
+		}
+	}
+}
+
+// closePartitionQueues tears down the queues for the partitions in cparts,
+// or all queues if cparts is nil, waiting for their pollers to return.
+func (c *Consumer) closePartitionQueues(cparts *C.rd_kafka_topic_partition_list_t) {
+	c.partitionQueuesLock.Lock()
+	var queues []*PartitionQueue
+	if cparts == nil {
+		for key, q := range c.partitionQueues {
+			queues = append(queues, q)
+			delete(c.partitionQueues, key)
+		}
+	} else {
+		for _, tp := range newTopicPartitionsFromCparts(cparts) {
+			key := topicPartitionKey{*tp.Topic, tp.Partition}
+			if q, found := c.partitionQueues[key]; found {
+				queues = append(queues, q)
+				delete(c.partitionQueues, key)
+			}
+		}
+	}
+	c.partitionQueuesLock.Unlock()
+
+	for _, q := range queues {
+		q.destroy()
+	}
+}
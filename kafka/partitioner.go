@@ -0,0 +1,202 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kafka
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+/*
+#include <stdint.h>
+#include "select_rdkafka.h"
+
+// Exported by partitionerCb below.
+extern int32_t partitionerCb(rd_kafka_topic_t *rkt, void *keydata,
+                             size_t keylen, int32_t partition_cnt,
+                             uintptr_t cgoid);
+
+// As this file exports a Go function its C functions must be static.
+
+// Partitioner callback of the topics with a Go partitioner.
+// The rkt_opaque is the cgoid of the partitioner.
+static int32_t partitioner_cb_trampoline (const rd_kafka_topic_t *rkt,
+                                          const void *keydata, size_t keylen,
+                                          int32_t partition_cnt,
+                                          void *rkt_opaque, void *msg_opaque) {
+  return partitionerCb((rd_kafka_topic_t *)rkt, (void *)keydata, keylen,
+                       partition_cnt, (uintptr_t)rkt_opaque);
+}
+
+static void set_partitioner (rd_kafka_topic_conf_t *tconf, uintptr_t cgoid) {
+  rd_kafka_topic_conf_set_partitioner_cb(tconf, partitioner_cb_trampoline);
+  rd_kafka_topic_conf_set_opaque(tconf, (void *)cgoid);
+}
+
+// Set the Go partitioner cgoid on the default topic configuration of conf.
+static void set_default_partitioner (rd_kafka_conf_t *conf, uintptr_t cgoid) {
+  rd_kafka_topic_conf_t *tconf = rd_kafka_conf_get_default_topic_conf(conf);
+  if (!tconf) {
+    tconf = rd_kafka_topic_conf_new();
+    rd_kafka_conf_set_default_topic_conf(conf, tconf);
+  }
+  set_partitioner(tconf, cgoid);
+}
+
+// Returns a copy of the default topic configuration of rk with the Go
+// partitioner cgoid.
+static rd_kafka_topic_conf_t *new_partitioner_topic_conf (rd_kafka_t *rk,
+                                                          uintptr_t cgoid) {
+  rd_kafka_topic_conf_t *tconf = rd_kafka_default_topic_conf_dup(rk);
+  set_partitioner(tconf, cgoid);
+  return tconf;
+}
+
+static int partition_available (rd_kafka_topic_t *rkt, int32_t partition) {
+  return rd_kafka_topic_partition_available(rkt, partition);
+}
+*/
+import "C"
+
+// PartitionerFunc is a Go partitioner, returning the partition of a
+// message produced to PartitionAny.
+//
+// The partitioner is passed the message's topic, key (nil for a null key)
+// and the topic's partitionCount, and must return a partition between 0
+// and partitionCount-1, or PartitionAny if partitioning could not be
+// performed, which fails the message.
+// available reports whether a partition has a leader broker; it must only
+// be called before the partitioner returns.
+//
+// The partitioner may be called from any go-routine or librdkafka thread,
+// at any time, possibly several times for the same message. It must not
+// call the client's methods, block or execute for prolonged periods of
+// time.
+type PartitionerFunc func(topic string, key []byte, partitionCount int32, available func(partition int32) bool) int32
+
+// cgoPartitioner is the cgoif container of a Go partitioner, in the global
+// cgo map: the partitioner callback is only passed its cgoid, as
+// rkt_opaque.
+type cgoPartitioner struct {
+	partitioner PartitionerFunc
+}
+
+//export partitionerCb
+func partitionerCb(crkt *C.rd_kafka_topic_t, keydata unsafe.Pointer, keylen C.size_t,
+	partitionCnt C.int32_t, cgoid C.uintptr_t) (partition C.int32_t) {
+	cg, found := globalCgoGet(uintptr(cgoid))
+	if !found {
+		return C.RD_KAFKA_PARTITION_UA
+	}
+
+	var key []byte
+	if keydata != nil {
+		key = C.GoBytes(keydata, C.int(keylen))
+	}
+
+	available := func(partition int32) bool {
+		return C.partition_available(crkt, C.int32_t(partition)) == 1
+	}
+
+	// A panic must not unwind through librdkafka: fail the message instead.
+	defer func() {
+		if recover() != nil {
+			partition = C.RD_KAFKA_PARTITION_UA
+		}
+	}()
+
+	p := cg.(*cgoPartitioner).partitioner(C.GoString(C.rd_kafka_topic_name(crkt)), key,
+		int32(partitionCnt), available)
+	if p < 0 || p >= int32(partitionCnt) {
+		return C.RD_KAFKA_PARTITION_UA
+	}
+	return C.int32_t(p)
+}
+
+// extractPartitioners extracts the go.partitioner and go.topic.partitioners
+// configuration properties.
+func (m ConfigMap) extractPartitioners() (defaultPartitioner PartitionerFunc, topicPartitioners map[string]PartitionerFunc, err error) {
+	v, err := m.extract("go.partitioner", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch partitioner := v.(type) {
+	case nil:
+	case PartitionerFunc:
+		defaultPartitioner = partitioner
+	case func(string, []byte, int32, func(int32) bool) int32:
+		defaultPartitioner = partitioner
+	default:
+		return nil, nil, newErrorFromString(ErrInvalidArg,
+			fmt.Sprintf("go.partitioner expects type kafka.PartitionerFunc, not %T", v))
+	}
+
+	v, err = m.extract("go.topic.partitioners", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if v != nil {
+		var ok bool
+		topicPartitioners, ok = v.(map[string]PartitionerFunc)
+		if !ok {
+			return nil, nil, newErrorFromString(ErrInvalidArg,
+				fmt.Sprintf("go.topic.partitioners expects type map[string]kafka.PartitionerFunc, not %T", v))
+		}
+		for topic, partitioner := range topicPartitioners {
+			if partitioner == nil {
+				return nil, nil, newErrorFromString(ErrInvalidArg,
+					fmt.Sprintf("go.topic.partitioners: nil partitioner for topic %s", topic))
+			}
+		}
+	}
+
+	return defaultPartitioner, topicPartitioners, nil
+}
+
+// setupPartitioners registers the Go partitioners of the handle in the
+// global cgo map, setting the default partitioner on cConf.
+// The per-topic partitioners are set on their topic configuration when
+// the topic objects are created, see getRkt0().
+func (h *handle) setupPartitioners(cConf *C.rd_kafka_conf_t, defaultPartitioner PartitionerFunc, topicPartitioners map[string]PartitionerFunc) {
+	if defaultPartitioner != nil {
+		cgoid := h.globalCgoPut(&cgoPartitioner{partitioner: defaultPartitioner})
+		C.set_default_partitioner(cConf, C.uintptr_t(cgoid))
+	}
+
+	if len(topicPartitioners) == 0 {
+		return
+	}
+
+	h.topicPartitioners = make(map[string]uintptr, len(topicPartitioners))
+	for topic, partitioner := range topicPartitioners {
+		cgoid := h.globalCgoPut(&cgoPartitioner{partitioner: partitioner})
+		h.topicPartitioners[topic] = cgoid
+	}
+}
+
+// newTopicConf returns the topic configuration of a new topic object for
+// topic, or nil for the default topic configuration.
+func (h *handle) newTopicConf(topic string) *C.rd_kafka_topic_conf_t {
+	cgoid, found := h.topicPartitioners[topic]
+	if !found {
+		return nil
+	}
+
+	return C.new_partitioner_topic_conf(h.rk, C.uintptr_t(cgoid))
+}
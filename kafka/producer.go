@@ -125,6 +125,83 @@ rd_kafka_resp_err_t err;
       return RD_KAFKA_RESP_ERR_NO_ERROR;
 #endif
 }
+
+
+// A message of a produce_batch() call.
+// All pointers point to C memory.
+typedef struct batch_msg_s {
+  rd_kafka_topic_t *rkt;
+  int32_t   partition;
+  void     *val;        // NULL for a null value
+  size_t    val_len;
+  void     *key;        // NULL for a null key
+  size_t    key_len;
+  int64_t   timestamp;
+  tmphdr_t *tmphdrs;    // not freed by produce_batch()
+  size_t    tmphdrsCnt;
+  uintptr_t cgoid;
+  rd_kafka_resp_err_t err; // set by produce_batch()
+} batch_msg_t;
+
+// Produce the cnt messages msgs with rd_kafka_produceva(), setting the
+// err of each message.
+// Returns the number of messages that could not be enqueued.
+int produce_batch (rd_kafka_t *rk, int msgflags,
+                   batch_msg_t *msgs, size_t cnt) {
+  size_t i, j;
+  int failed = 0;
+
+  for (i = 0 ; i < cnt ; i++) {
+    batch_msg_t *m = &msgs[i];
+    rd_kafka_vu_t vus[8];
+    size_t vucnt = 0;
+    rd_kafka_headers_t *hdrs = NULL;
+    rd_kafka_error_t *error;
+
+    vus[vucnt].vtype = RD_KAFKA_VTYPE_RKT;
+    vus[vucnt++].u.rkt = m->rkt;
+    vus[vucnt].vtype = RD_KAFKA_VTYPE_PARTITION;
+    vus[vucnt++].u.i32 = m->partition;
+    vus[vucnt].vtype = RD_KAFKA_VTYPE_MSGFLAGS;
+    vus[vucnt++].u.i = msgflags;
+    vus[vucnt].vtype = RD_KAFKA_VTYPE_VALUE;
+    vus[vucnt].u.mem.ptr = m->val;
+    vus[vucnt++].u.mem.size = m->val_len;
+    vus[vucnt].vtype = RD_KAFKA_VTYPE_KEY;
+    vus[vucnt].u.mem.ptr = m->key;
+    vus[vucnt++].u.mem.size = m->key_len;
+    vus[vucnt].vtype = RD_KAFKA_VTYPE_TIMESTAMP;
+    vus[vucnt++].u.i64 = m->timestamp;
+    vus[vucnt].vtype = RD_KAFKA_VTYPE_OPAQUE;
+    vus[vucnt++].u.ptr = (void *)m->cgoid;
+
+    if (m->tmphdrsCnt > 0) {
+      hdrs = rd_kafka_headers_new(m->tmphdrsCnt);
+      for (j = 0 ; j < m->tmphdrsCnt ; j++) {
+        tmphdr_t *hdr = &m->tmphdrs[j];
+        rd_kafka_header_add(hdrs, hdr->key, -1,
+                            hdr->size == -1 ? NULL :
+                            (hdr->size == 0 ? "" : hdr->val),
+                            hdr->size == -1 ? 0 : hdr->size);
+      }
+      vus[vucnt].vtype = RD_KAFKA_VTYPE_HEADERS;
+      vus[vucnt++].u.headers = hdrs;
+    }
+
+    error = rd_kafka_produceva(rk, vus, vucnt);
+    if (error) {
+      m->err = rd_kafka_error_code(error);
+      rd_kafka_error_destroy(error);
+      if (hdrs)
+        rd_kafka_headers_destroy(hdrs);
+      failed++;
+    } else {
+      m->err = RD_KAFKA_RESP_ERR_NO_ERROR;
+    }
+  }
+
+  return failed;
+}
 */
 import "C"
 
@@ -146,6 +223,9 @@ type Producer struct {
 	// Terminates the poller() goroutine
 	pollerTermChan chan bool
 
+	// Dispatches the delivery reports of ProduceAsync() messages
+	deliveries *deliveryDispatcher
+
 	// checks if Producer has been closed or not.
 	isClosed uint32
 }
@@ -177,6 +257,10 @@ func (p *Producer) produce(msg *Message, msgFlags int, deliveryChan chan Event)
 		return newErrorFromString(ErrInvalidArg, "")
 	}
 
+	if p.handle.interceptors != nil {
+		msg = p.handle.interceptors.onSend(msg)
+	}
+
 	crkt := p.handle.getRkt(*msg.TopicPartition.Topic)
 
 	// Three problems:
@@ -313,25 +397,179 @@ func (p *Producer) Produce(msg *Message, deliveryChan chan Event) error {
 	return p.produce(msg, 0, deliveryChan)
 }
 
-// Produce a batch of messages.
+// ProduceBatch produces a batch of messages with a single call into
+// librdkafka, as many Produce() calls would.
 // These batches do not relate to the message batches sent to the broker, the latter
 // are collected on the fly internally in librdkafka.
-// WARNING: This is an experimental API.
-// NOTE: timestamps and headers are not supported with this API.
-func (p *Producer) produceBatch(topic string, msgs []*Message, msgFlags int) error {
-	crkt := p.handle.getRkt(topic)
+//
+// Each message may have its own topic, partition, headers, timestamp and
+// opaque. The delivery reports are sent on the provided deliveryChan if
+// specified, or on the Producer object's Events() channel if not.
+//
+// Returns nil if all messages were enqueued. Otherwise the
+// TopicPartition.Error of each message that could not be enqueued is set,
+// no delivery report will be emitted for these messages, and an error with
+// the code of the first of them is returned.
+func (p *Producer) ProduceBatch(msgs []*Message, deliveryChan chan Event) error {
+	err := p.verifyClient()
+	if err != nil {
+		return err
+	}
 
-	cmsgs := make([]C.rd_kafka_message_t, len(msgs))
+	failed := p.produceBatch(msgs, 0, deliveryChan)
+	if failed == 0 {
+		return nil
+	}
+
+	for _, m := range msgs {
+		if m != nil && m.TopicPartition.Error != nil {
+			return newErrorFromString(m.TopicPartition.Error.(Error).Code(),
+				fmt.Sprintf("%d of %d messages could not be enqueued: first error: %s",
+					failed, len(msgs), m.TopicPartition.Error))
+		}
+	}
+
+	return newErrorFromString(ErrInvalidArg,
+		fmt.Sprintf("%d of %d messages could not be enqueued", failed, len(msgs)))
+}
+
+// produceBatch produces msgs with a single cgo call, setting the
+// TopicPartition.Error of the messages that could not be enqueued.
+// Returns the number of messages that could not be enqueued.
+func (p *Producer) produceBatch(msgs []*Message, msgFlags int, deliveryChan chan Event) (failed int) {
+	if p.handle.interceptors == nil {
+		return p.enqueueBatch(msgs, msgFlags, deliveryChan)
+	}
+
+	// Produce the copies of the messages passed to the interceptors, and
+	// set the errors of the messages from them.
+	sent := make([]*Message, len(msgs))
 	for i, m := range msgs {
-		p.handle.messageToC(m, &cmsgs[i])
+		if m != nil {
+			sent[i] = p.handle.interceptors.onSend(m)
+		}
 	}
-	r := C.rd_kafka_produce_batch(crkt, C.RD_KAFKA_PARTITION_UA, C.int(msgFlags)|C.RD_KAFKA_MSG_F_FREE,
-		(*C.rd_kafka_message_t)(&cmsgs[0]), C.int(len(msgs)))
-	if r == -1 {
-		return newError(C.rd_kafka_last_error())
+
+	failed = p.enqueueBatch(sent, msgFlags, deliveryChan)
+
+	for i, m := range sent {
+		if m != nil {
+			msgs[i].TopicPartition.Error = m.TopicPartition.Error
+		}
 	}
 
-	return nil
+	return failed
+}
+
+// enqueueBatch enqueues the messages of produceBatch().
+func (p *Producer) enqueueBatch(msgs []*Message, msgFlags int, deliveryChan chan Event) (failed int) {
+	// Due to cgo constraints the messages, their headers, keys and values
+	// are copied to C memory, with one allocation each.
+	var valid []int
+	dataLen := 0
+	hdrCnt := 0
+	for i, m := range msgs {
+		if m == nil {
+			failed++
+			continue
+		}
+		if m.TopicPartition.Topic == nil || len(*m.TopicPartition.Topic) == 0 {
+			m.TopicPartition.Error = newErrorFromString(ErrInvalidArg, "Message has no topic")
+			failed++
+			continue
+		}
+		m.TopicPartition.Error = nil
+		valid = append(valid, i)
+		dataLen += len(m.Value) + len(m.Key)
+		hdrCnt += len(m.Headers)
+		for _, hdr := range m.Headers {
+			dataLen += len(hdr.Key) + 1 + len(hdr.Value)
+		}
+	}
+
+	if len(valid) == 0 {
+		return failed
+	}
+
+	cmsgs := unsafe.Slice((*C.batch_msg_t)(C.calloc(C.size_t(len(valid)), C.sizeof_batch_msg_t)), len(valid))
+	defer C.free(unsafe.Pointer(&cmsgs[0]))
+
+	// One extra byte such that empty keys and values have a non-NULL pointer
+	data := unsafe.Slice((*byte)(C.malloc(C.size_t(dataLen+1))), dataLen+1)
+	defer C.free(unsafe.Pointer(&data[0]))
+
+	var tmphdrs []C.tmphdr_t
+	if hdrCnt > 0 {
+		tmphdrs = unsafe.Slice((*C.tmphdr_t)(C.calloc(C.size_t(hdrCnt), C.sizeof_tmphdr_t)), hdrCnt)
+		defer C.free(unsafe.Pointer(&tmphdrs[0]))
+	}
+
+	// toC copies b to data, returning its C pointer, or nil if b is nil.
+	off := 0
+	toC := func(b []byte) unsafe.Pointer {
+		if b == nil {
+			return nil
+		}
+		ptr := unsafe.Pointer(&data[off])
+		off += copy(data[off:], b)
+		return ptr
+	}
+
+	hdrOff := 0
+	for n, i := range valid {
+		m := msgs[i]
+		cmsg := &cmsgs[n]
+
+		cmsg.rkt = p.handle.getRkt(*m.TopicPartition.Topic)
+		cmsg.partition = C.int32_t(m.TopicPartition.Partition)
+		cmsg.val = toC(m.Value)
+		cmsg.val_len = C.size_t(len(m.Value))
+		cmsg.key = toC(m.Key)
+		cmsg.key_len = C.size_t(len(m.Key))
+		if !m.Timestamp.IsZero() {
+			cmsg.timestamp = C.int64_t(m.Timestamp.UnixNano() / 1000000)
+		}
+
+		if len(m.Headers) > 0 {
+			cmsg.tmphdrs = &tmphdrs[hdrOff]
+			cmsg.tmphdrsCnt = C.size_t(len(m.Headers))
+			for _, hdr := range m.Headers {
+				tmphdr := &tmphdrs[hdrOff]
+				hdrOff++
+				tmphdr.key = (*C.char)(toC(append([]byte(hdr.Key), 0)))
+				if hdr.Value == nil {
+					tmphdr.size = C.ssize_t(-1)
+				} else {
+					tmphdr.size = C.ssize_t(len(hdr.Value))
+					tmphdr.val = toC(hdr.Value)
+				}
+			}
+		}
+
+		// See produce()
+		if deliveryChan != nil || m.Opaque != nil {
+			cmsg.cgoid = C.uintptr_t(p.handle.cgoPut(cgoDr{deliveryChan: deliveryChan, opaque: m.Opaque}))
+		}
+	}
+
+	if C.produce_batch(p.handle.rk, C.int(msgFlags)|C.RD_KAFKA_MSG_F_COPY,
+		&cmsgs[0], C.size_t(len(cmsgs))) == 0 {
+		return failed
+	}
+
+	for n, i := range valid {
+		cmsg := &cmsgs[n]
+		if cmsg.err == C.RD_KAFKA_RESP_ERR_NO_ERROR {
+			continue
+		}
+		msgs[i].TopicPartition.Error = newError(cmsg.err)
+		if cmsg.cgoid != 0 {
+			p.handle.cgoGet(int(cmsg.cgoid))
+		}
+		failed++
+	}
+
+	return failed
 }
 
 // Events returns the Events channel (read)
@@ -416,10 +654,13 @@ func (p *Producer) Close() {
 	p.handle.waitGroup.Wait()
 
 	close(p.events)
+	p.deliveries.close()
 
 	p.handle.cleanup()
 
 	C.rd_kafka_destroy(p.handle.rk)
+
+	p.handle.globalCgoRelease()
 }
 
 const (
@@ -491,6 +732,12 @@ func (p *Producer) Purge(flags int) error {
 //	go.produce.channel.size (int, 1000000) - ProduceChannel() buffer size (in number of messages)
 //	go.logs.channel.enable (bool, false) - Forward log to Logs() channel.
 //	go.logs.channel (chan kafka.LogEvent, nil) - Forward logs to application-provided channel instead of Logs(). Requires go.logs.channel.enable=true.
+//	go.logs.slog (*slog.Logger, nil) - Log to the provided logger instead of Logs(), see LogEvent.LogTo(). Mutually exclusive with go.logs.channel.enable.
+//	go.stats.cb (kafka.StatsCb, nil) - Call the provided function with each Stats event, in addition to returning the event. Used by metrics exporters.
+//	go.partitioner (kafka.PartitionerFunc, nil) - Go partitioner of the messages produced to PartitionAny, overriding the partitioner property.
+//	go.topic.partitioners (map[string]kafka.PartitionerFunc, nil) - Per-topic Go partitioners, overriding go.partitioner for these topics.
+//	go.producer.interceptors ([]kafka.ProducerInterceptor, nil) - Interceptors of the produced messages, called in order, see ProducerInterceptor.
+//	go.oauthbearer.token.provider (kafka.OAuthBearerTokenProvider, nil) - Retrieve and set the SASL/OAUTHBEARER tokens with the provider, instead of emitting OAuthBearerTokenRefresh events.
 func NewProducer(conf *ConfigMap) (*Producer, error) {
 
 	err := versionCheck()
@@ -546,7 +793,27 @@ func NewProducer(conf *ConfigMap) (*Producer, error) {
 	}
 	produceChannelSize := v.(int)
 
-	logsChanEnable, logsChan, err := confCopy.extractLogConfig()
+	logsChanEnable, logsChan, logger, err := confCopy.extractLogConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	p.handle.statsCb, err = confCopy.extractStatsCb()
+	if err != nil {
+		return nil, err
+	}
+
+	defaultPartitioner, topicPartitioners, err := confCopy.extractPartitioners()
+	if err != nil {
+		return nil, err
+	}
+
+	producerInterceptors, err := confCopy.extractProducerInterceptors()
+	if err != nil {
+		return nil, err
+	}
+
+	tokenProvider, err := confCopy.extractOAuthBearerTokenProvider()
 	if err != nil {
 		return nil, err
 	}
@@ -571,9 +838,23 @@ func NewProducer(conf *ConfigMap) (*Producer, error) {
 
 	C.rd_kafka_conf_set_events(cConf, C.RD_KAFKA_EVENT_DR|C.RD_KAFKA_EVENT_STATS|C.RD_KAFKA_EVENT_ERROR|C.RD_KAFKA_EVENT_OAUTHBEARER_TOKEN_REFRESH)
 
+	if tokenProvider != nil {
+		enableSaslQueue(cConf)
+	}
+
+	p.handle.setupPartitioners(cConf, defaultPartitioner, topicPartitioners)
+
+	err = p.handle.setupInterceptors(cConf, producerInterceptors, nil)
+	if err != nil {
+		C.rd_kafka_conf_destroy(cConf)
+		p.handle.globalCgoRelease()
+		return nil, err
+	}
+
 	// Create librdkafka producer instance
 	p.handle.rk = C.rd_kafka_new(C.RD_KAFKA_PRODUCER, cConf, cErrstr, 256)
 	if p.handle.rk == nil {
+		p.handle.globalCgoRelease()
 		return nil, newErrorFromCString(C.RD_KAFKA_RESP_ERR__INVALID_ARG, cErrstr)
 	}
 
@@ -584,9 +865,15 @@ func NewProducer(conf *ConfigMap) (*Producer, error) {
 	p.produceChannel = make(chan *Message, produceChannelSize)
 	p.pollerTermChan = make(chan bool)
 	p.isClosed = 0
+	p.deliveries = newDeliveryDispatcher()
+	go p.deliveries.run()
+
+	if logsChanEnable || logger != nil {
+		p.handle.setupLogQueue(logsChan, logger, p.pollerTermChan)
+	}
 
-	if logsChanEnable {
-		p.handle.setupLogQueue(logsChan, p.pollerTermChan)
+	if tokenProvider != nil {
+		p.handle.setupOAuthBearerTokenProvider(tokenProvider, p.pollerTermChan)
 	}
 
 	p.handle.waitGroup.Add(1)
@@ -626,15 +913,11 @@ func channelProducer(p *Producer) {
 // channelBatchProducer serves the ProduceChannel channel and attempts to
 // improve cgo performance by using the produceBatch() interface.
 func channelBatchProducer(p *Producer) {
-	var buffered = make(map[string][]*Message)
-	bufferedCnt := 0
+	var buffered []*Message
 	const batchSize int = 1000000
-	totMsgCnt := 0
-	totBatchCnt := 0
 
 	for m := range p.produceChannel {
-		buffered[*m.TopicPartition.Topic] = append(buffered[*m.TopicPartition.Topic], m)
-		bufferedCnt++
+		buffered = append(buffered, m)
 
 	loop2:
 		for true {
@@ -649,9 +932,8 @@ func channelBatchProducer(p *Producer) {
 				if m.TopicPartition.Topic == nil {
 					panic(fmt.Sprintf("message without Topic received on ProduceChannel: %v", m))
 				}
-				buffered[*m.TopicPartition.Topic] = append(buffered[*m.TopicPartition.Topic], m)
-				bufferedCnt++
-				if bufferedCnt >= batchSize {
+				buffered = append(buffered, m)
+				if len(buffered) >= batchSize {
 					break loop2
 				}
 			default:
@@ -659,21 +941,15 @@ func channelBatchProducer(p *Producer) {
 			}
 		}
 
-		totBatchCnt++
-		totMsgCnt += len(buffered)
-
-		for topic, buffered2 := range buffered {
-			err := p.produceBatch(topic, buffered2, C.RD_KAFKA_MSG_F_BLOCK)
-			if err != nil {
-				for _, m = range buffered2 {
-					m.TopicPartition.Error = err
+		if p.produceBatch(buffered, C.RD_KAFKA_MSG_F_BLOCK, nil) > 0 {
+			for _, m = range buffered {
+				if m != nil && m.TopicPartition.Error != nil {
 					p.events <- m
 				}
 			}
 		}
 
-		buffered = make(map[string][]*Message)
-		bufferedCnt = 0
+		buffered = nil
 	}
 }
 
@@ -707,6 +983,19 @@ func (p *Producer) GetMetadata(topic *string, allTopics bool, timeoutMs int) (*M
 	return getMetadata(p, topic, allTopics, timeoutMs)
 }
 
+// GetMetadataContext queries broker for cluster and topic metadata,
+// see GetMetadata().
+// The request is bounded by the `ctx` deadline, or by `socket.timeout.ms`
+// if `ctx` has none, and the call returns ctx.Err() as soon as `ctx` is
+// done.
+func (p *Producer) GetMetadataContext(ctx context.Context, topic *string, allTopics bool) (*Metadata, error) {
+	err := p.verifyClient()
+	if err != nil {
+		return nil, err
+	}
+	return getMetadataContext(ctx, p, topic, allTopics)
+}
+
 // QueryWatermarkOffsets returns the broker's low and high offsets for the given topic
 // and partition.
 func (p *Producer) QueryWatermarkOffsets(topic string, partition int32, timeoutMs int) (low, high int64, err error) {
@@ -717,6 +1006,19 @@ func (p *Producer) QueryWatermarkOffsets(topic string, partition int32, timeoutM
 	return queryWatermarkOffsets(p, topic, partition, timeoutMs)
 }
 
+// QueryWatermarkOffsetsContext returns the broker's low and high offsets for
+// the given topic and partition.
+// The request is bounded by the `ctx` deadline, or by `socket.timeout.ms`
+// if `ctx` has none, and the call returns ctx.Err() as soon as `ctx` is
+// done.
+func (p *Producer) QueryWatermarkOffsetsContext(ctx context.Context, topic string, partition int32) (low, high int64, err error) {
+	err = p.verifyClient()
+	if err != nil {
+		return -1, -1, err
+	}
+	return queryWatermarkOffsetsContext(ctx, p, topic, partition)
+}
+
 // OffsetsForTimes looks up offsets by timestamp for the given partitions.
 //
 // The returned offset for each partition is the earliest offset whose
@@ -740,6 +1042,19 @@ func (p *Producer) OffsetsForTimes(times []TopicPartition, timeoutMs int) (offse
 	return offsetsForTimes(p, times, timeoutMs)
 }
 
+// OffsetsForTimesContext looks up offsets by timestamp for the given
+// partitions, see OffsetsForTimes().
+// The lookup is bounded by the `ctx` deadline, or by `socket.timeout.ms`
+// if `ctx` has none, and the call returns ctx.Err() as soon as `ctx` is
+// done.
+func (p *Producer) OffsetsForTimesContext(ctx context.Context, times []TopicPartition) (offsets []TopicPartition, err error) {
+	err = p.verifyClient()
+	if err != nil {
+		return nil, err
+	}
+	return offsetsForTimesContext(ctx, p, times)
+}
+
 // GetFatalError returns an Error object if the client instance has raised a fatal error, else nil.
 func (p *Producer) GetFatalError() error {
 	err := p.verifyClient()
@@ -223,3 +223,63 @@ func BenchmarkProducerInternalMessageToC(b *testing.B) {
 		p.handle.messageToCDummy(&msg)
 	}
 }
+
+// producerEnqueuePerfTest measures the cost of enqueuing b.N messages with
+// headers, batchSize at a time, without a broker.
+func producerEnqueuePerfTest(b *testing.B, batchSize int, produceFunc func(p *Producer, msgs []*Message) error) {
+	p, err := NewProducer(&ConfigMap{
+		"go.delivery.reports":          false,
+		"queue.buffering.max.messages": 1000000,
+		"queue.buffering.max.kbytes":   2097151,
+	})
+	if err != nil {
+		b.Fatalf("NewProducer failed: %s", err)
+	}
+	defer p.Close()
+
+	topic := "test"
+	buf := []byte(strings.Repeat("Ten bytes!", 10))
+	msgs := make([]*Message, batchSize)
+	for i := range msgs {
+		msgs[i] = &Message{
+			TopicPartition: TopicPartition{Topic: &topic, Partition: int32(i % 4)},
+			Value:          buf,
+			Headers:        []Header{{Key: "hdr", Value: []byte("value")}},
+		}
+	}
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(buf)))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i += batchSize {
+		if p.Len() >= 500000 {
+			b.StopTimer()
+			p.Purge(PurgeQueue)
+			b.StartTimer()
+		}
+
+		err = produceFunc(p, msgs)
+		if err != nil {
+			b.Fatalf("Produce failed: %s", err)
+		}
+	}
+}
+
+func BenchmarkProducerInternalEnqueueProduce(b *testing.B) {
+	producerEnqueuePerfTest(b, 1, func(p *Producer, msgs []*Message) error {
+		return p.Produce(msgs[0], nil)
+	})
+}
+
+func BenchmarkProducerInternalEnqueueProduceBatch100(b *testing.B) {
+	producerEnqueuePerfTest(b, 100, func(p *Producer, msgs []*Message) error {
+		return p.ProduceBatch(msgs, nil)
+	})
+}
+
+func BenchmarkProducerInternalEnqueueProduceBatch1000(b *testing.B) {
+	producerEnqueuePerfTest(b, 1000, func(p *Producer, msgs []*Message) error {
+		return p.ProduceBatch(msgs, nil)
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"reflect"
 	"strings"
 	"testing"
@@ -189,6 +190,141 @@ func TestProducerAPIs(t *testing.T) {
 	p.Close()
 }
 
+// TestProducerContextAPIs dry-tests the context-aware Producer APIs,
+// no broker is needed.
+func TestProducerContextAPIs(t *testing.T) {
+	p, err := NewProducer(&ConfigMap{
+		"bootstrap.servers": "127.0.0.1:65533",
+	})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	defer p.Close()
+
+	topic := "gotest"
+
+	// Deadline expiry
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	start := time.Now()
+	_, err = p.GetMetadataContext(ctx, &topic, false)
+	duration := time.Since(start)
+	cancel()
+	t.Logf("GetMetadataContext() returned %v in %v", err, duration)
+	if err == nil {
+		t.Errorf("GetMetadataContext() should have failed")
+	}
+	if duration > 2*time.Second {
+		t.Errorf("GetMetadataContext() should have returned within the deadline, took %v", duration)
+	}
+
+	// Cancellation
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	time.AfterFunc(200*time.Millisecond, cancel)
+	start = time.Now()
+	_, _, err = p.QueryWatermarkOffsetsContext(ctx, topic, 0)
+	duration = time.Since(start)
+	cancel()
+	t.Logf("QueryWatermarkOffsetsContext() returned %v in %v", err, duration)
+	if err != context.Canceled {
+		t.Errorf("QueryWatermarkOffsetsContext() should have returned %v, not %v", context.Canceled, err)
+	}
+	if duration > 2*time.Second {
+		t.Errorf("QueryWatermarkOffsetsContext() should have returned promptly on cancellation, took %v", duration)
+	}
+
+	// An already cancelled context returns immediately.
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = p.OffsetsForTimesContext(ctx, []TopicPartition{{Topic: &topic, Offset: 12345}})
+	if err != context.Canceled {
+		t.Errorf("OffsetsForTimesContext() should have returned %v, not %v", context.Canceled, err)
+	}
+}
+
+// TestProducerProduceSync dry-tests ProduceSync() and ProduceAsync(),
+// no broker is needed.
+func TestProducerProduceSync(t *testing.T) {
+	p, err := NewProducer(&ConfigMap{
+		"bootstrap.servers":  "127.0.0.1:65533",
+		"message.timeout.ms": 5000,
+	})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	topic := "gotest"
+	pending := func() int {
+		p.deliveries.lock.Lock()
+		defer p.deliveries.lock.Unlock()
+		return len(p.deliveries.pending)
+	}
+
+	// Cancellation discards the pending delivery
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	msg, err := p.ProduceSync(ctx, &Message{
+		TopicPartition: TopicPartition{Topic: &topic, Partition: 0},
+		Value:          []byte("sync"),
+	})
+	cancel()
+	if msg != nil || err != context.DeadlineExceeded {
+		t.Errorf("ProduceSync() should have returned %v, not %v, %v",
+			context.DeadlineExceeded, msg, err)
+	}
+	if n := pending(); n != 0 {
+		t.Errorf("Cancelled deliveries should not be pending, got %d", n)
+	}
+
+	_, err = p.ProduceSync(context.Background(), &Message{})
+	if err == nil || err.(Error).Code() != ErrInvalidArg {
+		t.Errorf("ProduceSync() without a topic should have returned ErrInvalidArg, not %v", err)
+	}
+	if n := pending(); n != 0 {
+		t.Errorf("Failed produce calls should not be pending, got %d", n)
+	}
+
+	f, err := p.ProduceAsync(&Message{
+		TopicPartition: TopicPartition{Topic: &topic, Partition: 0},
+		Value:          []byte("async"),
+		Opaque:         "opaque",
+	})
+	if err != nil {
+		t.Fatalf("ProduceAsync() failed: %s", err)
+	}
+	_, err = f.Result()
+	if err == nil || err.(Error).Code() != ErrState {
+		t.Errorf("Result() of a pending delivery should have returned ErrState, not %v", err)
+	}
+
+	// The delivery fails once message.timeout.ms expires
+	select {
+	case <-f.Done():
+	case <-time.After(30 * time.Second):
+		t.Fatalf("Delivery report not received")
+	}
+	msg, err = f.Result()
+	if err == nil || err.(Error).Code() != ErrMsgTimedOut {
+		t.Errorf("Expected ErrMsgTimedOut, not %v", err)
+	}
+	if msg == nil || msg.Opaque != "opaque" || string(msg.Value) != "async" {
+		t.Errorf("The delivered message should have the produced Opaque and Value, got %v", msg)
+	}
+
+	// Closing the producer fails pending deliveries
+	f, err = p.ProduceAsync(&Message{
+		TopicPartition: TopicPartition{Topic: &topic, Partition: 0},
+		Value:          []byte("closed"),
+	})
+	if err != nil {
+		t.Fatalf("ProduceAsync() failed: %s", err)
+	}
+	p.Close()
+
+	msg, err = f.Wait(context.Background())
+	if msg != nil || err == nil || err.(Error).Code() != ErrDestroy {
+		t.Errorf("Expected ErrDestroy once closed, not %v, %v", msg, err)
+	}
+}
+
 // Test on Closed Producer
 func TestOnClosedProducer(t *testing.T) {
 	p, err := NewProducer(&ConfigMap{
@@ -397,6 +533,74 @@ func TestProducerOAuthBearerConfig(t *testing.T) {
 	p.Close()
 }
 
+// testTokenProvider is an OAuthBearerTokenProvider whose first failures
+// calls fail, sending the config of each call to calls.
+type testTokenProvider struct {
+	failures int
+	callCnt  int
+	calls    chan string
+}
+
+func (tp *testTokenProvider) Token(ctx context.Context, config string) (OAuthBearerToken, error) {
+	tp.callCnt++
+	tp.calls <- config
+	if tp.callCnt <= tp.failures {
+		return OAuthBearerToken{}, errors.New("token retrieval failure")
+	}
+	return OAuthBearerToken{
+		TokenValue: "aaaa",
+		Expiration: time.Now().Add(time.Hour),
+		Principal:  "gotest",
+	}, nil
+}
+
+// TestProducerOAuthBearerTokenProvider tests that the
+// go.oauthbearer.token.provider is called, and retried on failure, to
+// refresh the token.
+func TestProducerOAuthBearerTokenProvider(t *testing.T) {
+	myOAuthConfig := "scope=myscope principal=gotest"
+	provider := &testTokenProvider{failures: 2, calls: make(chan string, 10)}
+
+	p, err := NewProducer(&ConfigMap{
+		"security.protocol":             "SASL_PLAINTEXT",
+		"sasl.mechanisms":               "OAUTHBEARER",
+		"sasl.oauthbearer.config":       myOAuthConfig,
+		"go.oauthbearer.token.provider": provider,
+	})
+	if err != nil {
+		t.Fatalf("NewProducer failed: %s", err)
+	}
+
+	// Two failures and a successful retry.
+	for i := 1; i <= 3; i++ {
+		select {
+		case config := <-provider.calls:
+			if config != myOAuthConfig {
+				t.Errorf("Expected config %s, not %s", myOAuthConfig, config)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Token provider call %d not received", i)
+		}
+	}
+
+	select {
+	case config := <-provider.calls:
+		t.Errorf("Unexpected token provider call with config %s", config)
+	case ev := <-p.Events():
+		if _, ok := ev.(OAuthBearerTokenRefresh); ok {
+			t.Errorf("Unexpected event %v", ev)
+		}
+	case <-time.After(time.Second):
+	}
+
+	p.Close()
+
+	_, err = NewProducer(&ConfigMap{"go.oauthbearer.token.provider": "provider"})
+	if err == nil || err.(Error).Code() != ErrInvalidArg {
+		t.Errorf("Expected ErrInvalidArg for an invalid provider, got %v", err)
+	}
+}
+
 func TestProducerLog(t *testing.T) {
 	p, err := NewProducer(&ConfigMap{
 		"debug":                  "all",
@@ -805,3 +1009,145 @@ func runProducerDeliveryReportFieldTest(t *testing.T, config *ConfigMap, fn func
 		t.Errorf("Expected empty queue after Flush, still has %d", r)
 	}
 }
+
+// TestProducerProduceBatch dry-tests ProduceBatch(), no broker is needed.
+func TestProducerProduceBatch(t *testing.T) {
+	p, err := NewProducer(&ConfigMap{
+		"bootstrap.servers":            "127.0.0.1:65533",
+		"message.timeout.ms":           1000,
+		"queue.buffering.max.messages": 4,
+	})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	defer p.Close()
+
+	topic := "gotest"
+	msgs := []*Message{
+		{TopicPartition: TopicPartition{Topic: &topic, Partition: 0},
+			Value: []byte("value"), Key: []byte("key"),
+			Headers:   []Header{{Key: "hdr", Value: []byte("hdrval")}, {Key: "null"}},
+			Timestamp: time.Unix(1600000000, 0), Opaque: 1},
+		{TopicPartition: TopicPartition{Topic: &topic, Partition: PartitionAny},
+			Value: []byte{}, Opaque: 2},
+		{TopicPartition: TopicPartition{Partition: 0}, Value: []byte("no topic"), Opaque: 3},
+		{TopicPartition: TopicPartition{Topic: &topic, Partition: 1}, Opaque: 4},
+	}
+
+	drChan := make(chan Event, len(msgs))
+	err = p.ProduceBatch(msgs, drChan)
+	if err == nil || err.(Error).Code() != ErrInvalidArg {
+		t.Errorf("Expected ErrInvalidArg for the message without topic, got %v", err)
+	}
+	for i, m := range msgs {
+		if (i == 2) != (m.TopicPartition.Error != nil) {
+			t.Errorf("Message %d: unexpected error %v", i, m.TopicPartition.Error)
+		}
+	}
+
+	// The enqueued messages time out
+	for i := 0; i < 3; i++ {
+		select {
+		case ev := <-drChan:
+			m := ev.(*Message)
+			if m.TopicPartition.Error == nil || m.TopicPartition.Error.(Error).Code() != ErrMsgTimedOut {
+				t.Errorf("Expected ErrMsgTimedOut, got %v", m.TopicPartition.Error)
+			}
+			switch m.Opaque {
+			case 1:
+				if string(m.Value) != "value" || string(m.Key) != "key" {
+					t.Errorf("Unexpected key or value: %v", m)
+				}
+				if len(m.Headers) != 2 || m.Headers[0].String() != `hdr="hdrval"` ||
+					m.Headers[1].Value != nil {
+					t.Errorf("Unexpected headers: %v", m.Headers)
+				}
+				if m.Timestamp.Unix() != 1600000000 {
+					t.Errorf("Unexpected timestamp: %v", m.Timestamp)
+				}
+			case 2:
+				if m.Value == nil || len(m.Value) != 0 {
+					t.Errorf("Expected an empty value, got %v", m.Value)
+				}
+			case 4:
+				if m.Value != nil {
+					t.Errorf("Expected a null value, got %v", m.Value)
+				}
+			default:
+				t.Errorf("Unexpected delivery report: %v", m)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Delivery report not received")
+		}
+	}
+
+	// The queue is full
+	full := make([]*Message, 5)
+	for i := range full {
+		full[i] = &Message{TopicPartition: TopicPartition{Topic: &topic, Partition: 0}}
+	}
+	err = p.ProduceBatch(full, nil)
+	if err == nil || err.(Error).Code() != ErrQueueFull {
+		t.Errorf("Expected ErrQueueFull, got %v", err)
+	}
+	if full[3].TopicPartition.Error != nil || full[4].TopicPartition.Error == nil {
+		t.Errorf("Expected only the fifth message to fail, got %v and %v",
+			full[3].TopicPartition.Error, full[4].TopicPartition.Error)
+	}
+	p.Purge(PurgeQueue)
+}
+
+// TestProducerPartitionerConfig tests the go.partitioner and
+// go.topic.partitioners configuration properties.
+func TestProducerPartitionerConfig(t *testing.T) {
+	partitioner := func(topic string, key []byte, partitionCount int32, available func(int32) bool) int32 {
+		return 0
+	}
+
+	for _, conf := range []ConfigMap{
+		{"go.partitioner": "murmur2"},
+		{"go.topic.partitioners": map[string]func(){"topic": nil}},
+		{"go.topic.partitioners": map[string]PartitionerFunc{"topic": nil}},
+	} {
+		_, err := NewProducer(&conf)
+		if err == nil || err.(Error).Code() != ErrInvalidArg {
+			t.Errorf("Expected ErrInvalidArg for %v, got %v", conf, err)
+		}
+	}
+
+	globalCgo.lock.RLock()
+	registered := len(globalCgo.cgomap)
+	globalCgo.lock.RUnlock()
+
+	p, err := NewProducer(&ConfigMap{
+		"go.partitioner": partitioner,
+		"go.topic.partitioners": map[string]PartitionerFunc{
+			"topic1": partitioner,
+			"topic2": partitioner,
+		},
+	})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	globalCgo.lock.RLock()
+	if len(globalCgo.cgomap) != registered+3 {
+		t.Errorf("Expected 3 registered partitioners, got %d", len(globalCgo.cgomap)-registered)
+	}
+	globalCgo.lock.RUnlock()
+
+	topic := "topic1"
+	err = p.Produce(&Message{TopicPartition: TopicPartition{Topic: &topic, Partition: PartitionAny}}, nil)
+	if err != nil {
+		t.Errorf("Produce failed: %s", err)
+	}
+	p.Purge(PurgeQueue)
+
+	p.Close()
+
+	globalCgo.lock.RLock()
+	if len(globalCgo.cgomap) != registered {
+		t.Errorf("Expected the partitioners to be released on Close, got %d", len(globalCgo.cgomap)-registered)
+	}
+	globalCgo.lock.RUnlock()
+}
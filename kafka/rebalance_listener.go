@@ -0,0 +1,170 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kafka
+
+import (
+	"context"
+)
+
+/*
+#include "select_rdkafka.h"
+*/
+import "C"
+
+// RebalanceListener is notified of the partitions assigned to and revoked
+// from a consumer subscribed with SubscribeTopicsWithListener(), as the
+// Java client's ConsumerRebalanceListener.
+//
+// The consumer performs the assign and unassign calls matching the group's
+// rebalance protocol (eager or cooperative): the listener must not call
+// *Assign() or *Unassign().
+//
+// With the cooperative protocol only the incrementally assigned or revoked
+// partitions are passed, with the eager protocol the full assignment.
+//
+// The listener is called from the goroutine polling the consumer.
+// An error returned by the listener is returned by the poll call as an
+// Error with code ErrApplication, unless it is already an Error.
+type RebalanceListener interface {
+	// OnPartitionsAssigned is called once partitions are assigned, before
+	// they are consumed from, e.g., to Seek() them.
+	OnPartitionsAssigned(ctx context.Context, c *Consumer, partitions []TopicPartition) error
+	// OnPartitionsRevoked is called before partitions are unassigned,
+	// e.g., to store or commit the offsets of processed messages.
+	OnPartitionsRevoked(ctx context.Context, c *Consumer, partitions []TopicPartition) error
+	// OnPartitionsLost is called, instead of OnPartitionsRevoked(), when
+	// the partitions were lost, e.g., after a session timeout, and may
+	// already be owned by other members of the group: their offsets
+	// must not be committed.
+	OnPartitionsLost(ctx context.Context, c *Consumer, partitions []TopicPartition) error
+}
+
+// SubscribeTopicsWithListener subscribes to the provided list of topics,
+// notifying listener of partition assignment changes.
+// ctx is passed to the listener.
+// This replaces the current subscription.
+//
+// With `go.rebalance.commit.on.revoke=true` the stored offsets are
+// committed synchronously after OnPartitionsRevoked() returns, before the
+// partitions are unassigned.
+func (c *Consumer) SubscribeTopicsWithListener(ctx context.Context, topics []string, listener RebalanceListener) error {
+	if listener == nil {
+		return newErrorFromString(ErrInvalidArg, "listener must not be nil")
+	}
+
+	err := c.SubscribeTopics(topics, nil)
+	if err != nil {
+		return err
+	}
+
+	c.rebalanceListener = listener
+	c.rebalanceListenerCtx = ctx
+
+	return nil
+}
+
+// handleRebalanceListener notifies the RebalanceListener of the rebalance
+// event rkev and performs the matching assign or unassign call.
+//
+// Returns the error of the listener or of the assign call, if any.
+func (c *Consumer) handleRebalanceListener(rkev *C.rd_kafka_event_t) Event {
+	listener := c.rebalanceListener
+	ctx := c.rebalanceListenerCtx
+	partitions := newTopicPartitionsFromCparts(C.rd_kafka_event_topic_partition_list(rkev))
+
+	var err error
+	if C.rd_kafka_event_error(rkev) == C.RD_KAFKA_RESP_ERR__ASSIGN_PARTITIONS {
+		err = c.rebalanceAssign(rkev)
+		if err == nil {
+			err = listener.OnPartitionsAssigned(ctx, c, partitions)
+		}
+	} else {
+		if c.AssignmentLost() {
+			err = listener.OnPartitionsLost(ctx, c, partitions)
+		} else {
+			err = listener.OnPartitionsRevoked(ctx, c, partitions)
+		}
+
+		// The partitions are unassigned regardless of the listener's
+		// error, as librdkafka requires.
+		assignErr := c.rebalanceAssign(rkev)
+		if err == nil {
+			err = assignErr
+		}
+	}
+
+	if err == nil {
+		return nil
+	}
+	return newRebalanceErrorEvent(err)
+}
+
+// newRebalanceErrorEvent returns the error err of a rebalance as an Error
+// event, wrapping application errors as ErrApplication.
+func newRebalanceErrorEvent(err error) Event {
+	if kerr, ok := err.(Error); ok {
+		return kerr
+	}
+	return newErrorFromString(ErrApplication, err.Error())
+}
+
+// rebalanceAssign performs the assign or unassign call of the rebalance event
+// rkev matching the rebalance protocol, committing the stored offsets
+// before revoked partitions are unassigned if so configured.
+func (c *Consumer) rebalanceAssign(rkev *C.rd_kafka_event_t) (err error) {
+	isCooperative := c.GetRebalanceProtocol() == "COOPERATIVE"
+	var cError *C.rd_kafka_error_t
+	var cErr C.rd_kafka_resp_err_t
+
+	if C.rd_kafka_event_error(rkev) == C.RD_KAFKA_RESP_ERR__ASSIGN_PARTITIONS {
+		// Assign partitions
+		if isCooperative {
+			cError = C.rd_kafka_incremental_assign(
+				c.handle.rk,
+				C.rd_kafka_event_topic_partition_list(rkev))
+		} else {
+			cErr = C.rd_kafka_assign(
+				c.handle.rk,
+				C.rd_kafka_event_topic_partition_list(rkev))
+		}
+	} else {
+		// Revoke partitions
+
+		if c.commitOnRevoke && !c.AssignmentLost() {
+			_, commitErr := c.commit(context.Background(), nil)
+			if kerr, ok := commitErr.(Error); ok && kerr.Code() != ErrNoOffset {
+				err = kerr
+			}
+		}
+
+		if isCooperative {
+			cError = C.rd_kafka_incremental_unassign(
+				c.handle.rk,
+				C.rd_kafka_event_topic_partition_list(rkev))
+		} else {
+			cErr = C.rd_kafka_assign(c.handle.rk, nil)
+		}
+	}
+
+	if cError != nil {
+		return newErrorFromCErrorDestroy(cError)
+	} else if cErr != 0 {
+		return newError(cErr)
+	}
+
+	return err
+}
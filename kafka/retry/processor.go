@@ -0,0 +1,317 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package retry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// Config holds the Processor configuration.
+type Config struct {
+	// Delays of the retry topics, in increasing order, e.g.
+	// {time.Minute, 10 * time.Minute}.
+	// A message failing its n'th attempt is published to the n'th retry
+	// topic, see RetryTopic(), and to the DLQ once all are exhausted.
+	// An empty Delays publishes failed messages directly to the DLQ.
+	Delays []time.Duration
+	// DLQTopic overrides the DLQ topic of all topics (default DLQTopic(topic)).
+	DLQTopic string
+	// PollTimeout is the maximum consumer poll timeout (default 100ms).
+	PollTimeout time.Duration
+	// ErrorCb is called with non-fatal consumer errors, if set.
+	ErrorCb func(err error)
+}
+
+// partitionKey identifies a paused partition
+type partitionKey struct {
+	topic     string
+	partition int32
+}
+
+// Processor consumes messages from a kafka.Consumer and processes them with
+// a Handler, republishing failed messages with a kafka.Producer to the
+// retry topics and finally to the DLQ.
+//
+// The consumer must be configured with `enable.auto.offset.store=false`:
+// a message's offset is only stored once it has been processed or
+// republished, so that no message is lost.
+// The retry topics and the DLQ topics must exist or be auto-created.
+type Processor struct {
+	c       *kafka.Consumer
+	p       *kafka.Producer
+	handler Handler
+	config  Config
+
+	// retryTopics is the set of consumed retry topics
+	retryTopics map[string]bool
+	// paused retry topic partitions, by the time they are due
+	paused map[partitionKey]time.Time
+}
+
+// NewProcessor creates a new Processor consuming from consumer c,
+// processing messages with handler and republishing failed messages with
+// producer p.
+func NewProcessor(c *kafka.Consumer, p *kafka.Producer, handler Handler, config Config) (*Processor, error) {
+	if c == nil || p == nil || handler == nil {
+		return nil, kafka.NewError(kafka.ErrInvalidArg,
+			"Consumer, producer and handler are required", false)
+	}
+
+	for i, delay := range config.Delays {
+		if delay <= 0 || (i > 0 && delay < config.Delays[i-1]) {
+			return nil, kafka.NewError(kafka.ErrInvalidArg,
+				"Delays must be positive and in increasing order", false)
+		}
+	}
+
+	if config.PollTimeout < 0 {
+		return nil, kafka.NewError(kafka.ErrInvalidArg,
+			"PollTimeout must not be negative", false)
+	}
+	if config.PollTimeout == 0 {
+		config.PollTimeout = 100 * time.Millisecond
+	}
+
+	return &Processor{
+		c:           c,
+		p:           p,
+		handler:     handler,
+		config:      config,
+		retryTopics: make(map[string]bool),
+		paused:      make(map[partitionKey]time.Time),
+	}, nil
+}
+
+// String returns a human readable name for a Processor instance
+func (r *Processor) String() string {
+	return fmt.Sprintf("RetryProcessor(%s)", r.c)
+}
+
+// Topics returns the topics consumed by the Processor for topics:
+// topics and their retry topics.
+func (r *Processor) Topics(topics []string) []string {
+	all := append([]string{}, topics...)
+	for _, topic := range topics {
+		for _, delay := range r.config.Delays {
+			all = append(all, RetryTopic(topic, delay))
+		}
+	}
+	return all
+}
+
+// Run subscribes the consumer to topics and their retry topics and
+// processes messages until ctx is done or an error occurs.
+//
+// Returns ctx.Err(), a fatal consumer error, or the error of a
+// failed republish, in which case the message's offset is not stored.
+func (r *Processor) Run(ctx context.Context, topics []string) error {
+	r.retryTopics = make(map[string]bool)
+	for _, topic := range topics {
+		for _, delay := range r.config.Delays {
+			r.retryTopics[RetryTopic(topic, delay)] = true
+		}
+	}
+
+	err := r.c.SubscribeTopics(r.Topics(topics), r.rebalance)
+	if err != nil {
+		return err
+	}
+
+	for ctx.Err() == nil {
+		r.resumeDue()
+
+		switch e := r.c.Poll(r.pollTimeoutMs()).(type) {
+		case *kafka.Message:
+			if e.TopicPartition.Error != nil {
+				r.onError(e.TopicPartition.Error)
+				continue
+			}
+			err = r.handle(ctx, e)
+			if err != nil {
+				return err
+			}
+
+		case kafka.Error:
+			if e.IsFatal() {
+				return e
+			}
+			r.onError(e)
+		}
+	}
+
+	return ctx.Err()
+}
+
+// handle processes msg, delaying it if it is not yet due and
+// republishing it on failure.
+func (r *Processor) handle(ctx context.Context, msg *kafka.Message) error {
+	if r.retryTopics[*msg.TopicPartition.Topic] {
+		due := time.UnixMilli(getIntHeader(msg, HeaderDue, 0))
+		if time.Now().Before(due) {
+			return r.delay(msg, due)
+		}
+	}
+
+	err := r.handler(ctx, msg)
+	if err != nil {
+		err = r.republish(ctx, msg, err)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = r.c.StoreMessage(msg)
+	if err != nil {
+		r.onError(err)
+	}
+
+	return nil
+}
+
+// republish publishes the failed msg to its next retry topic,
+// or to the DLQ, and waits for the delivery.
+func (r *Processor) republish(ctx context.Context, msg *kafka.Message, handlerErr error) error {
+	attempts := int(getIntHeader(msg, HeaderAttempts, 0)) + 1
+
+	origTopic, found := getHeader(msg, HeaderOriginalTopic)
+	if !found {
+		origTopic = *msg.TopicPartition.Topic
+	}
+
+	var out *kafka.Message
+	if attempts <= len(r.config.Delays) && !IsPermanent(handlerErr) {
+		delay := r.config.Delays[attempts-1]
+		out = republished(msg, RetryTopic(origTopic, delay),
+			attempts, handlerErr, time.Now().Add(delay))
+	} else {
+		dlq := r.config.DLQTopic
+		if dlq == "" {
+			dlq = DLQTopic(origTopic)
+		}
+		out = republished(msg, dlq, attempts, handlerErr, time.Time{})
+	}
+
+	deliveryChan := make(chan kafka.Event, 1)
+	err := r.p.Produce(out, deliveryChan)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case ev := <-deliveryChan:
+		return ev.(*kafka.Message).TopicPartition.Error
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// delay pauses msg's partition until due and rewinds it to msg,
+// which is consumed again once the partition is resumed.
+func (r *Processor) delay(msg *kafka.Message, due time.Time) error {
+	tp := kafka.TopicPartition{
+		Topic:     msg.TopicPartition.Topic,
+		Partition: msg.TopicPartition.Partition,
+	}
+
+	err := r.c.Pause([]kafka.TopicPartition{tp})
+	if err != nil {
+		return err
+	}
+
+	tp.Offset = msg.TopicPartition.Offset
+	tp.LeaderEpoch = msg.TopicPartition.LeaderEpoch
+	err = r.c.Seek(tp, -1)
+	if err != nil {
+		return err
+	}
+
+	r.paused[partitionKey{*tp.Topic, tp.Partition}] = due
+	return nil
+}
+
+// resumeDue resumes the paused partitions whose next message is due.
+func (r *Processor) resumeDue() {
+	now := time.Now()
+	var resume []kafka.TopicPartition
+	for key, due := range r.paused {
+		if !now.Before(due) {
+			topic := key.topic
+			resume = append(resume, kafka.TopicPartition{Topic: &topic, Partition: key.partition})
+			delete(r.paused, key)
+		}
+	}
+
+	if len(resume) > 0 {
+		err := r.c.Resume(resume)
+		if err != nil {
+			r.onError(err)
+		}
+	}
+}
+
+// pollTimeoutMs returns the poll timeout, bounded by the time
+// the next paused partition is due.
+func (r *Processor) pollTimeoutMs() int {
+	timeout := r.config.PollTimeout
+	now := time.Now()
+	for _, due := range r.paused {
+		if d := due.Sub(now); d < timeout {
+			timeout = d
+		}
+	}
+	if timeout < 0 {
+		timeout = 0
+	}
+	return int(timeout / time.Millisecond)
+}
+
+// rebalance is the consumer's rebalance callback.
+func (r *Processor) rebalance(c *kafka.Consumer, ev kafka.Event) error {
+	if e, ok := ev.(kafka.RevokedPartitions); ok {
+		// Resume revoked partitions such that they are not paused
+		// if assigned again: they will be rewound to their committed offset.
+		var resume []kafka.TopicPartition
+		for _, tp := range e.Partitions {
+			key := partitionKey{*tp.Topic, tp.Partition}
+			if _, found := r.paused[key]; found {
+				resume = append(resume, tp)
+				delete(r.paused, key)
+			}
+		}
+
+		if len(resume) > 0 {
+			err := c.Resume(resume)
+			if err != nil {
+				r.onError(err)
+			}
+		}
+	}
+
+	// Let the consumer perform the (incremental) assign or unassign.
+	return nil
+}
+
+// onError reports a non-fatal error to the application's ErrorCb, if any.
+func (r *Processor) onError(err error) {
+	if r.config.ErrorCb != nil {
+		r.config.ErrorCb(err)
+	}
+}
@@ -0,0 +1,184 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProcessor(t *testing.T) {
+	assert := assert.New(t)
+
+	c, err := kafka.NewConsumer(&kafka.ConfigMap{"group.id": "retry"})
+	assert.NoError(err)
+	defer c.Close()
+
+	p, err := kafka.NewProducer(&kafka.ConfigMap{})
+	assert.NoError(err)
+	defer p.Close()
+
+	handler := func(ctx context.Context, msg *kafka.Message) error { return nil }
+
+	_, err = NewProcessor(c, nil, handler, Config{})
+	assert.Error(err, "A producer should be required")
+
+	_, err = NewProcessor(c, p, handler, Config{
+		Delays: []time.Duration{time.Minute, time.Second}})
+	assert.Error(err, "Decreasing delays should fail")
+
+	r, err := NewProcessor(c, p, handler, Config{
+		Delays: []time.Duration{time.Minute, 10 * time.Minute}})
+	assert.NoError(err)
+	assert.Equal([]string{"a", "b",
+		"a.retry.1m", "a.retry.10m", "b.retry.1m", "b.retry.10m"},
+		r.Topics([]string{"a", "b"}))
+}
+
+// TestProcessorRetryAndDLQ tests that failed messages are retried through
+// the retry topics once due, and finally published to the DLQ.
+func TestProcessorRetryAndDLQ(t *testing.T) {
+	assert := assert.New(t)
+
+	mockCluster, err := kafka.NewMockCluster(1)
+	assert.NoError(err, "Mock cluster creation should succeed")
+	defer mockCluster.Close()
+
+	topic := "orders"
+	delays := []time.Duration{200 * time.Millisecond, 500 * time.Millisecond}
+	for _, tp := range []string{topic, RetryTopic(topic, delays[0]),
+		RetryTopic(topic, delays[1]), DLQTopic(topic)} {
+		assert.NoError(mockCluster.CreateTopic(tp, 1, 1))
+	}
+
+	p, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+	})
+	assert.NoError(err, "Producer creation should succeed")
+	defer p.Close()
+
+	for _, value := range []string{"ok", "flaky", "poison"} {
+		err = p.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 0},
+			Value:          []byte(value),
+		}, nil)
+		assert.NoError(err)
+	}
+	assert.Zero(p.Flush(10 * 1000))
+
+	c, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":        mockCluster.BootstrapServers(),
+		"group.id":                 "retry",
+		"auto.offset.reset":        "earliest",
+		"enable.auto.offset.store": false,
+	})
+	assert.NoError(err, "Consumer creation should succeed")
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var lock sync.Mutex
+	attempts := make(map[string][]time.Time)
+	processed := false
+
+	r, err := NewProcessor(c, p, func(ctx context.Context, msg *kafka.Message) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		value := string(msg.Value)
+		attempts[value] = append(attempts[value], time.Now())
+
+		switch value {
+		case "flaky":
+			if len(attempts[value]) < 3 {
+				return errors.New("flaky failure")
+			}
+			processed = true
+		case "poison":
+			return errors.New("poison failure")
+		}
+		return nil
+	}, Config{Delays: delays})
+	assert.NoError(err)
+
+	// Read the DLQ to know when the poison message has exhausted its retries.
+	dlqConsumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+		"group.id":          "dlq",
+		"auto.offset.reset": "earliest",
+	})
+	assert.NoError(err)
+	defer dlqConsumer.Close()
+	assert.NoError(dlqConsumer.Subscribe(DLQTopic(topic), nil))
+
+	var dlqMsg *kafka.Message
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for ctx.Err() == nil {
+			m, err := dlqConsumer.ReadMessage(100 * time.Millisecond)
+			if err == nil {
+				dlqMsg = m
+				cancel()
+				return
+			}
+		}
+	}()
+
+	err = r.Run(ctx, []string{topic})
+	assert.Equal(context.Canceled, err)
+	<-done
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	assert.Len(attempts["ok"], 1, "Successful messages should be processed once")
+	assert.True(processed, "The flaky message should eventually be processed")
+	assert.Len(attempts["poison"], 3, "The poison message should be attempted once per tier")
+
+	// Retries are only attempted once due.
+	for _, value := range []string{"flaky", "poison"} {
+		for i, delay := range delays {
+			if i+1 < len(attempts[value]) {
+				assert.GreaterOrEqual(attempts[value][i+1].Sub(attempts[value][i]), delay,
+					"Attempt %d of %s should be delayed by %v", i+2, value, delay)
+			}
+		}
+	}
+
+	if assert.NotNil(dlqMsg, "The poison message should be published to the DLQ") {
+		assert.Equal("poison", string(dlqMsg.Value))
+		for key, value := range map[string]string{
+			HeaderOriginalTopic:     topic,
+			HeaderOriginalPartition: "0",
+			HeaderOriginalOffset:    "2",
+			HeaderAttempts:          "3",
+			HeaderError:             "poison failure",
+		} {
+			v, found := getHeader(dlqMsg, key)
+			assert.True(found, "Header %s should be set", key)
+			assert.Equal(value, v, "Header %s", key)
+		}
+	}
+}
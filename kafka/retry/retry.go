@@ -0,0 +1,189 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package retry provides retry topics and a dead letter queue (DLQ)
+// for messages that could not be processed by a consumer.
+//
+// A failed message is republished to the next of a series of retry topics
+// with increasing delays, e.g. <topic>.retry.1m and <topic>.retry.10m,
+// with a header holding the time at which it is due for another attempt.
+// The Processor consumes the retry topics along with the original topics and
+// pauses a retry topic partition until its next message is due.
+// Once all retries are exhausted, the message is published to the
+// dead letter queue, <topic>.dlq, with headers describing its origin,
+// the last error and the number of attempts.
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// Headers set on messages published to retry topics and the DLQ.
+const (
+	// HeaderOriginalTopic is the topic the message was originally consumed from
+	HeaderOriginalTopic = "retry.original.topic"
+	// HeaderOriginalPartition is the partition the message was originally consumed from
+	HeaderOriginalPartition = "retry.original.partition"
+	// HeaderOriginalOffset is the offset the message was originally consumed at
+	HeaderOriginalOffset = "retry.original.offset"
+	// HeaderAttempts is the number of failed processing attempts
+	HeaderAttempts = "retry.attempts"
+	// HeaderError is the error string of the last failed attempt
+	HeaderError = "retry.error"
+	// HeaderDue is the time at which the message is due for another
+	// attempt, in milliseconds since the epoch
+	HeaderDue = "retry.due"
+)
+
+// Handler processes a single message.
+//
+// A non-nil error causes the message to be retried, or published to the
+// DLQ if all retries are exhausted or the error is wrapped with Permanent().
+type Handler func(ctx context.Context, msg *kafka.Message) error
+
+// permanentError is an error which must not be retried.
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent wraps err to indicate that the message must not be retried
+// but directly published to the DLQ.
+func Permanent(err error) error {
+	return permanentError{err}
+}
+
+// IsPermanent returns true if err was wrapped with Permanent().
+func IsPermanent(err error) bool {
+	var perr permanentError
+	return errors.As(err, &perr)
+}
+
+// RetryTopic returns the name of the retry topic of topic with delay,
+// e.g. "orders.retry.1m".
+func RetryTopic(topic string, delay time.Duration) string {
+	return fmt.Sprintf("%s.retry.%s", topic, formatDelay(delay))
+}
+
+// DLQTopic returns the name of the default DLQ topic of topic,
+// e.g. "orders.dlq".
+func DLQTopic(topic string) string {
+	return topic + ".dlq"
+}
+
+// formatDelay returns a compact representation of delay in its largest
+// whole unit, e.g. "1m", "90s" or "1500ms".
+func formatDelay(delay time.Duration) string {
+	switch {
+	case delay >= time.Hour && delay%time.Hour == 0:
+		return fmt.Sprintf("%dh", delay/time.Hour)
+	case delay >= time.Minute && delay%time.Minute == 0:
+		return fmt.Sprintf("%dm", delay/time.Minute)
+	case delay >= time.Second && delay%time.Second == 0:
+		return fmt.Sprintf("%ds", delay/time.Second)
+	default:
+		return fmt.Sprintf("%dms", delay/time.Millisecond)
+	}
+}
+
+// getHeader returns the value of the last header named key, if any.
+func getHeader(msg *kafka.Message, key string) (string, bool) {
+	for i := len(msg.Headers) - 1; i >= 0; i-- {
+		if msg.Headers[i].Key == key {
+			return string(msg.Headers[i].Value), true
+		}
+	}
+	return "", false
+}
+
+// getIntHeader returns the integer value of the header named key, or def
+// if not set or invalid.
+func getIntHeader(msg *kafka.Message, key string, def int64) int64 {
+	s, found := getHeader(msg, key)
+	if !found {
+		return def
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+// isRetryHeader returns true if key is one of the headers set by this package.
+func isRetryHeader(key string) bool {
+	switch key {
+	case HeaderOriginalTopic, HeaderOriginalPartition, HeaderOriginalOffset,
+		HeaderAttempts, HeaderError, HeaderDue:
+		return true
+	}
+	return false
+}
+
+// republished returns a copy of msg to be published to topic, with the
+// retry headers updated for attempts failed attempts, the last one with err.
+// due is the time at which the message is due for another attempt, if any.
+func republished(msg *kafka.Message, topic string, attempts int, err error, due time.Time) *kafka.Message {
+	origTopic, found := getHeader(msg, HeaderOriginalTopic)
+	origPartition := strconv.Itoa(int(msg.TopicPartition.Partition))
+	origOffset := msg.TopicPartition.Offset.String()
+	if found {
+		origPartition, _ = getHeader(msg, HeaderOriginalPartition)
+		origOffset, _ = getHeader(msg, HeaderOriginalOffset)
+	} else {
+		origTopic = *msg.TopicPartition.Topic
+	}
+
+	var headers []kafka.Header
+	for _, h := range msg.Headers {
+		if !isRetryHeader(h.Key) {
+			headers = append(headers, h)
+		}
+	}
+
+	headers = append(headers,
+		kafka.Header{Key: HeaderOriginalTopic, Value: []byte(origTopic)},
+		kafka.Header{Key: HeaderOriginalPartition, Value: []byte(origPartition)},
+		kafka.Header{Key: HeaderOriginalOffset, Value: []byte(origOffset)},
+		kafka.Header{Key: HeaderAttempts, Value: []byte(strconv.Itoa(attempts))},
+		kafka.Header{Key: HeaderError, Value: []byte(err.Error())})
+
+	if !due.IsZero() {
+		headers = append(headers, kafka.Header{
+			Key:   HeaderDue,
+			Value: []byte(strconv.FormatInt(due.UnixMilli(), 10))})
+	}
+
+	return &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+		Key:            msg.Key,
+		Value:          msg.Value,
+		Headers:        headers,
+	}
+}
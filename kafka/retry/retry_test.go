@@ -0,0 +1,114 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package retry
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryTopic(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, tc := range []struct {
+		delay    time.Duration
+		expected string
+	}{
+		{time.Minute, "orders.retry.1m"},
+		{10 * time.Minute, "orders.retry.10m"},
+		{2 * time.Hour, "orders.retry.2h"},
+		{90 * time.Second, "orders.retry.90s"},
+		{1500 * time.Millisecond, "orders.retry.1500ms"},
+	} {
+		assert.Equal(tc.expected, RetryTopic("orders", tc.delay))
+	}
+
+	assert.Equal("orders.dlq", DLQTopic("orders"))
+}
+
+func TestPermanent(t *testing.T) {
+	assert := assert.New(t)
+
+	err := errors.New("invalid payload")
+	assert.False(IsPermanent(err))
+	assert.True(IsPermanent(Permanent(err)))
+	assert.True(IsPermanent(fmt.Errorf("wrapped: %w", Permanent(err))))
+	assert.ErrorIs(Permanent(err), err)
+}
+
+func TestRepublished(t *testing.T) {
+	assert := assert.New(t)
+
+	topic := "orders"
+	msg := &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 2, Offset: 42},
+		Key:            []byte("key"),
+		Value:          []byte("value"),
+		Headers:        []kafka.Header{{Key: "app", Value: []byte("header")}},
+	}
+
+	due := time.UnixMilli(1700000000000)
+	retried := republished(msg, RetryTopic(topic, time.Minute), 1, errors.New("first"), due)
+
+	assert.Equal("orders.retry.1m", *retried.TopicPartition.Topic)
+	assert.Equal(kafka.PartitionAny, retried.TopicPartition.Partition)
+	assert.Equal(msg.Key, retried.Key)
+	assert.Equal(msg.Value, retried.Value)
+
+	expected := map[string]string{
+		"app":                   "header",
+		HeaderOriginalTopic:     "orders",
+		HeaderOriginalPartition: "2",
+		HeaderOriginalOffset:    "42",
+		HeaderAttempts:          "1",
+		HeaderError:             "first",
+		HeaderDue:               "1700000000000",
+	}
+	for key, value := range expected {
+		v, found := getHeader(retried, key)
+		assert.True(found, "Header %s should be set", key)
+		assert.Equal(value, v, "Header %s", key)
+	}
+
+	// Republishing the retried message to the DLQ keeps the
+	// original coordinates and replaces the retry headers.
+	retryTopic := "orders.retry.1m"
+	retried.TopicPartition = kafka.TopicPartition{Topic: &retryTopic, Partition: 0, Offset: 7}
+	dlq := republished(retried, DLQTopic(topic), 2, errors.New("second"), time.Time{})
+
+	assert.Equal("orders.dlq", *dlq.TopicPartition.Topic)
+	assert.Len(dlq.Headers, 6, "Retry headers should not be duplicated")
+	for key, value := range map[string]string{
+		"app":                   "header",
+		HeaderOriginalTopic:     "orders",
+		HeaderOriginalPartition: "2",
+		HeaderOriginalOffset:    "42",
+		HeaderAttempts:          "2",
+		HeaderError:             "second",
+	} {
+		v, found := getHeader(dlq, key)
+		assert.True(found, "Header %s should be set", key)
+		assert.Equal(value, v, "Header %s", key)
+	}
+	_, found := getHeader(dlq, HeaderDue)
+	assert.False(found, "DLQ messages should not be due")
+}
@@ -0,0 +1,351 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kafka
+
+import (
+	"encoding/json"
+)
+
+// Typed model of the librdkafka statistics emitted as Stats events
+// when `statistics.interval.ms` is set.
+// See https://github.com/confluentinc/librdkafka/blob/master/STATISTICS.md
+// for a description of each field.
+//
+// Time values are in microseconds unless noted otherwise.
+
+// Statistics is the top-level librdkafka statistics document.
+type Statistics struct {
+	// Name is the handle instance name
+	Name string `json:"name"`
+	// ClientID is the configured client.id
+	ClientID string `json:"client_id"`
+	// Type is the instance type (producer or consumer)
+	Type string `json:"type"`
+	// Ts is librdkafka's internal monotonic clock
+	Ts int64 `json:"ts"`
+	// Time is the wall clock time in seconds since the epoch
+	Time int64 `json:"time"`
+	// Age is the time since this client instance was created
+	Age int64 `json:"age"`
+	// ReplyQ is the number of ops waiting in queue for the application to serve
+	ReplyQ int64 `json:"replyq"`
+	// MsgCnt is the current number of messages in producer queues
+	MsgCnt int64 `json:"msg_cnt"`
+	// MsgSize is the current total size of messages in producer queues
+	MsgSize int64 `json:"msg_size"`
+	// MsgMax is the threshold: maximum number of messages allowed on the producer queues
+	MsgMax int64 `json:"msg_max"`
+	// MsgSizeMax is the threshold: maximum total size of messages allowed on the producer queues
+	MsgSizeMax int64 `json:"msg_size_max"`
+	// SimpleCnt is internal tracking of legacy vs new consumer API state
+	SimpleCnt int64 `json:"simple_cnt"`
+	// MetadataCacheCnt is the number of topics in the metadata cache
+	MetadataCacheCnt int64 `json:"metadata_cache_cnt"`
+	// Tx is the total number of requests sent to Kafka brokers
+	Tx int64 `json:"tx"`
+	// TxBytes is the total number of bytes transmitted to Kafka brokers
+	TxBytes int64 `json:"tx_bytes"`
+	// Rx is the total number of responses received from Kafka brokers
+	Rx int64 `json:"rx"`
+	// RxBytes is the total number of bytes received from Kafka brokers
+	RxBytes int64 `json:"rx_bytes"`
+	// TxMsgs is the total number of messages transmitted (produced) to Kafka brokers
+	TxMsgs int64 `json:"txmsgs"`
+	// TxMsgBytes is the total number of message bytes (including framing) transmitted to Kafka brokers
+	TxMsgBytes int64 `json:"txmsg_bytes"`
+	// RxMsgs is the total number of messages consumed, not including ignored messages, from Kafka brokers
+	RxMsgs int64 `json:"rxmsgs"`
+	// RxMsgBytes is the total number of message bytes (including framing) received from Kafka brokers
+	RxMsgBytes int64 `json:"rxmsg_bytes"`
+
+	// Brokers by broker name
+	Brokers map[string]BrokerStats `json:"brokers"`
+	// Topics by topic name
+	Topics map[string]TopicStats `json:"topics"`
+	// Cgrp is the consumer group state, for consumers with a group.id
+	Cgrp *ConsumerGroupStats `json:"cgrp,omitempty"`
+	// EOS is the idempotent and transactional producer state
+	EOS *EOSStats `json:"eos,omitempty"`
+}
+
+// WindowStats holds rolling window statistics.
+// The values are in microseconds unless otherwise noted.
+type WindowStats struct {
+	Min    int64   `json:"min"`
+	Max    int64   `json:"max"`
+	Avg    int64   `json:"avg"`
+	Sum    int64   `json:"sum"`
+	Cnt    int64   `json:"cnt"`
+	Stddev float64 `json:"stddev"`
+	// HdrSize is the memory size of the HDR histogram
+	HdrSize int64 `json:"hdrsize"`
+	P50     int64 `json:"p50"`
+	P75     int64 `json:"p75"`
+	P90     int64 `json:"p90"`
+	P95     int64 `json:"p95"`
+	P99     int64 `json:"p99"`
+	P9999   int64 `json:"p99_99"`
+	// OutOfRange is the number of values not included in the
+	// underlying histogram
+	OutOfRange int64 `json:"outofrange"`
+}
+
+// BrokerTopicPartition identifies a partition handled by a broker.
+type BrokerTopicPartition struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+}
+
+// BrokerStats holds the statistics of a broker connection.
+type BrokerStats struct {
+	// Name is the broker hostname, port and broker id
+	Name string `json:"name"`
+	// NodeID is the broker id (-1 for bootstraps)
+	NodeID int32 `json:"nodeid"`
+	// NodeName is the broker hostname
+	NodeName string `json:"nodename"`
+	// Source is the broker source (learned, configured, internal, logical)
+	Source string `json:"source"`
+	// State is the broker state (INIT, DOWN, CONNECT, AUTH, APIVERSION_QUERY, AUTH_HANDSHAKE, UP, UPDATE)
+	State string `json:"state"`
+	// StateAge is the time since last broker state change
+	StateAge int64 `json:"stateage"`
+	// OutbufCnt is the number of requests awaiting transmission to broker
+	OutbufCnt int64 `json:"outbuf_cnt"`
+	// OutbufMsgCnt is the number of messages awaiting transmission to broker
+	OutbufMsgCnt int64 `json:"outbuf_msg_cnt"`
+	// WaitrespCnt is the number of requests in-flight to broker awaiting response
+	WaitrespCnt int64 `json:"waitresp_cnt"`
+	// WaitrespMsgCnt is the number of messages in-flight to broker awaiting response
+	WaitrespMsgCnt int64 `json:"waitresp_msg_cnt"`
+	// Tx is the total number of requests sent
+	Tx int64 `json:"tx"`
+	// TxBytes is the total number of bytes sent
+	TxBytes int64 `json:"txbytes"`
+	// TxErrs is the total number of transmission errors
+	TxErrs int64 `json:"txerrs"`
+	// TxRetries is the total number of request retries
+	TxRetries int64 `json:"txretries"`
+	// TxIdle is the time since last socket send (or -1 if no sends yet)
+	TxIdle int64 `json:"txidle"`
+	// ReqTimeouts is the total number of requests timed out
+	ReqTimeouts int64 `json:"req_timeouts"`
+	// Rx is the total number of responses received
+	Rx int64 `json:"rx"`
+	// RxBytes is the total number of bytes received
+	RxBytes int64 `json:"rxbytes"`
+	// RxErrs is the total number of receive errors
+	RxErrs int64 `json:"rxerrs"`
+	// RxCorridErrs is the total number of unmatched correlation ids in response
+	RxCorridErrs int64 `json:"rxcorriderrs"`
+	// RxPartial is the total number of partial MessageSets received
+	RxPartial int64 `json:"rxpartial"`
+	// RxIdle is the time since last socket receive (or -1 if no receives yet)
+	RxIdle int64 `json:"rxidle"`
+	// ZbufGrow is the total number of decompression buffer size increases
+	ZbufGrow int64 `json:"zbuf_grow"`
+	// BufGrow is the total number of buffer size increases (deprecated, unused)
+	BufGrow int64 `json:"buf_grow"`
+	// Wakeups is the number of broker thread poll loop wakeups
+	Wakeups int64 `json:"wakeups"`
+	// Connects is the number of connection attempts, including successful and failed, and name resolution failures
+	Connects int64 `json:"connects"`
+	// Disconnects is the number of disconnects (triggered by broker, network, load-balancer, etc.)
+	Disconnects int64 `json:"disconnects"`
+	// Req is the number of requests sent by request type
+	Req map[string]int64 `json:"req"`
+	// IntLatency is the internal producer queue latency
+	IntLatency WindowStats `json:"int_latency"`
+	// OutbufLatency is the internal request queue latency
+	OutbufLatency WindowStats `json:"outbuf_latency"`
+	// Rtt is the broker latency / round-trip time
+	Rtt WindowStats `json:"rtt"`
+	// Throttle is the broker throttling time, in milliseconds
+	Throttle WindowStats `json:"throttle"`
+	// TopPars are the partitions handled by this broker, by "topic-partition"
+	TopPars map[string]BrokerTopicPartition `json:"toppars"`
+}
+
+// TopicStats holds the statistics of a topic.
+type TopicStats struct {
+	// Topic is the topic name
+	Topic string `json:"topic"`
+	// Age is the age of the client's topic object, in milliseconds
+	Age int64 `json:"age"`
+	// MetadataAge is the age of metadata from broker for this topic, in milliseconds
+	MetadataAge int64 `json:"metadata_age"`
+	// BatchSize is the batch sizes in bytes
+	BatchSize WindowStats `json:"batchsize"`
+	// BatchCnt is the batch message counts
+	BatchCnt WindowStats `json:"batchcnt"`
+	// Partitions by partition id
+	Partitions map[string]PartitionStats `json:"partitions"`
+}
+
+// PartitionStats holds the statistics of a topic partition.
+//
+// The internal unassigned partition, used by the producer for messages
+// pending partitioning, has Partition -1.
+type PartitionStats struct {
+	// Partition is the partition id (-1 for the internal unassigned partition)
+	Partition int32 `json:"partition"`
+	// Broker is the id of the broker messages are currently being fetched from
+	Broker int32 `json:"broker"`
+	// Leader is the current leader broker id
+	Leader int32 `json:"leader"`
+	// Desired is true if the partition is explicitly desired by the application
+	Desired bool `json:"desired"`
+	// Unknown is true if the partition is not seen in topic metadata from broker
+	Unknown bool `json:"unknown"`
+	// MsgqCnt is the number of messages waiting to be produced in first-level queue
+	MsgqCnt int64 `json:"msgq_cnt"`
+	// MsgqBytes is the number of bytes in MsgqCnt
+	MsgqBytes int64 `json:"msgq_bytes"`
+	// XmitMsgqCnt is the number of messages ready to be produced in transmit queue
+	XmitMsgqCnt int64 `json:"xmit_msgq_cnt"`
+	// XmitMsgqBytes is the number of bytes in XmitMsgqCnt
+	XmitMsgqBytes int64 `json:"xmit_msgq_bytes"`
+	// FetchqCnt is the number of pre-fetched messages in fetch queue
+	FetchqCnt int64 `json:"fetchq_cnt"`
+	// FetchqSize is the bytes in FetchqCnt
+	FetchqSize int64 `json:"fetchq_size"`
+	// FetchState is the consumer fetch state for this partition
+	// (none, stopping, stopped, offset-query, offset-wait, active)
+	FetchState string `json:"fetch_state"`
+	// QueryOffset is the current/last logical offset query
+	QueryOffset int64 `json:"query_offset"`
+	// NextOffset is the next offset to fetch
+	NextOffset int64 `json:"next_offset"`
+	// AppOffset is the offset of last message passed to application + 1
+	AppOffset int64 `json:"app_offset"`
+	// StoredOffset is the offset to be committed
+	StoredOffset int64 `json:"stored_offset"`
+	// StoredLeaderEpoch is the partition leader epoch of the stored offset
+	StoredLeaderEpoch int32 `json:"stored_leader_epoch"`
+	// CommittedOffset is the last committed offset
+	CommittedOffset int64 `json:"committed_offset"`
+	// CommittedLeaderEpoch is the partition leader epoch of the committed offset
+	CommittedLeaderEpoch int32 `json:"committed_leader_epoch"`
+	// EOFOffset is the last PARTITION_EOF signaled offset
+	EOFOffset int64 `json:"eof_offset"`
+	// LoOffset is the partition's low watermark offset on broker
+	LoOffset int64 `json:"lo_offset"`
+	// HiOffset is the partition's high watermark offset on broker
+	HiOffset int64 `json:"hi_offset"`
+	// LsOffset is the partition's last stable offset on broker, or the
+	// high watermark offset if the last stable offset is not supported by the broker
+	LsOffset int64 `json:"ls_offset"`
+	// ConsumerLag is the difference between (HiOffset or LsOffset) and
+	// CommittedOffset, or -1 if unknown
+	ConsumerLag int64 `json:"consumer_lag"`
+	// ConsumerLagStored is the difference between (HiOffset or LsOffset) and
+	// StoredOffset, or -1 if unknown
+	ConsumerLagStored int64 `json:"consumer_lag_stored"`
+	// LeaderEpoch is the last known partition leader epoch, or -1 if unknown
+	LeaderEpoch int32 `json:"leader_epoch"`
+	// TxMsgs is the total number of messages transmitted (produced)
+	TxMsgs int64 `json:"txmsgs"`
+	// TxBytes is the total number of bytes transmitted for TxMsgs
+	TxBytes int64 `json:"txbytes"`
+	// RxMsgs is the total number of messages consumed, not including ignored messages
+	RxMsgs int64 `json:"rxmsgs"`
+	// RxBytes is the total number of bytes received for RxMsgs
+	RxBytes int64 `json:"rxbytes"`
+	// Msgs is the total number of messages received (consumer) or produced (producer)
+	Msgs int64 `json:"msgs"`
+	// RxVerDrops is the number of dropped outdated messages
+	RxVerDrops int64 `json:"rx_ver_drops"`
+	// MsgsInflight is the current number of messages in-flight to/from broker
+	MsgsInflight int64 `json:"msgs_inflight"`
+	// NextAckSeq is the next expected acked sequence (idempotent producer)
+	NextAckSeq int64 `json:"next_ack_seq"`
+	// NextErrSeq is the next expected errored sequence (idempotent producer)
+	NextErrSeq int64 `json:"next_err_seq"`
+	// AckedMsgID is the last acked internal message id (idempotent producer)
+	AckedMsgID int64 `json:"acked_msgid"`
+}
+
+// ConsumerGroupStats holds the consumer group state.
+type ConsumerGroupStats struct {
+	// State is the local consumer group handler's state
+	State string `json:"state"`
+	// StateAge is the time elapsed since last state change, in milliseconds
+	StateAge int64 `json:"stateage"`
+	// JoinState is the local consumer group handler's join state
+	JoinState string `json:"join_state"`
+	// RebalanceAge is the time elapsed since last rebalance (assign or revoke), in milliseconds
+	RebalanceAge int64 `json:"rebalance_age"`
+	// RebalanceCnt is the total number of rebalances (assign or revoke)
+	RebalanceCnt int64 `json:"rebalance_cnt"`
+	// RebalanceReason is the reason for the last rebalance
+	RebalanceReason string `json:"rebalance_reason"`
+	// AssignmentSize is the current assignment's partition count
+	AssignmentSize int64 `json:"assignment_size"`
+}
+
+// EOSStats holds the idempotent and transactional producer state.
+type EOSStats struct {
+	// IdempState is the current idempotent producer id state
+	IdempState string `json:"idemp_state"`
+	// IdempStateAge is the time elapsed since last IdempState change, in milliseconds
+	IdempStateAge int64 `json:"idemp_stateage"`
+	// TxnState is the current transactional producer state
+	TxnState string `json:"txn_state"`
+	// TxnStateAge is the time elapsed since last TxnState change, in milliseconds
+	TxnStateAge int64 `json:"txn_stateage"`
+	// TxnMayEnq is true if the transactional state allows enqueuing (producing) new messages
+	TxnMayEnq bool `json:"txn_may_enq"`
+	// ProducerID is the current producer id (or -1)
+	ProducerID int64 `json:"producer_id"`
+	// ProducerEpoch is the current producer epoch (or -1)
+	ProducerEpoch int64 `json:"producer_epoch"`
+	// EpochCnt is the number of producer id assignments since start
+	EpochCnt int64 `json:"epoch_cnt"`
+}
+
+// Parse parses the statistics JSON document into a Statistics struct.
+func (e Stats) Parse() (*Statistics, error) {
+	var s Statistics
+	err := json.Unmarshal([]byte(e.statsJSON), &s)
+	if err != nil {
+		return nil, newErrorFromString(ErrBadMsg,
+			"Failed to parse statistics: "+err.Error())
+	}
+	return &s, nil
+}
+
+// ConsumerLag returns the total consumer lag of the topic's partitions,
+// i.e., the sum of the known PartitionStats.ConsumerLag.
+func (t TopicStats) ConsumerLag() int64 {
+	var lag int64
+	for _, p := range t.Partitions {
+		if p.Partition >= 0 && p.ConsumerLag > 0 {
+			lag += p.ConsumerLag
+		}
+	}
+	return lag
+}
+
+// ConsumerLag returns the total consumer lag per topic, see
+// TopicStats.ConsumerLag().
+func (s *Statistics) ConsumerLag() map[string]int64 {
+	lags := make(map[string]int64, len(s.Topics))
+	for name, t := range s.Topics {
+		lags[name] = t.ConsumerLag()
+	}
+	return lags
+}
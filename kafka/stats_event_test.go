@@ -36,6 +36,14 @@ func handleStatsEvent(t *testing.T, eventCh chan Event, statsReceived chan bool)
 				t.Fatalf("json unmarshall error: %s", err)
 			}
 			t.Logf("Stats['name']: %s", raw["name"])
+
+			stats, err := e.Parse()
+			if err != nil {
+				t.Fatalf("Parse error: %s", err)
+			}
+			if stats.Name != raw["name"] {
+				t.Fatalf("Parsed name %s does not match %s", stats.Name, raw["name"])
+			}
 			close(statsReceived)
 			return
 		default:
@@ -129,3 +137,149 @@ func TestStatsEventConsumerChannel(t *testing.T) {
 	}
 
 }
+
+// statsJSONSample is a trimmed consumer statistics document
+const statsJSONSample = `{
+  "name": "rdkafka#consumer-1", "client_id": "rdkafka", "type": "consumer",
+  "ts": 5016483227792, "time": 1527060869, "age": 1003456,
+  "replyq": 0, "msg_cnt": 0, "msg_size": 0, "msg_max": 100000,
+  "msg_size_max": 1073741824, "simple_cnt": 0, "metadata_cache_cnt": 1,
+  "brokers": {
+    "localhost:9092/1": {
+      "name": "localhost:9092/1", "nodeid": 1, "nodename": "localhost:9092",
+      "source": "configured", "state": "UP", "stateage": 9057234,
+      "outbuf_cnt": 0, "outbuf_msg_cnt": 0, "waitresp_cnt": 1,
+      "waitresp_msg_cnt": 0, "tx": 320, "txbytes": 84283, "txerrs": 0,
+      "txretries": 0, "txidle": 2000, "req_timeouts": 0, "rx": 319,
+      "rxbytes": 1081962, "rxerrs": 0, "rxcorriderrs": 0, "rxpartial": 0,
+      "rxidle": 1000, "zbuf_grow": 0, "buf_grow": 0, "wakeups": 591067,
+      "connects": 1, "disconnects": 0,
+      "req": {"Fetch": 312, "Metadata": 2},
+      "int_latency": {"min": 0, "max": 0, "avg": 0, "sum": 0, "cnt": 0,
+        "stddev": 0, "hdrsize": 11376, "p50": 0, "p75": 0, "p90": 0,
+        "p95": 0, "p99": 0, "p99_99": 0, "outofrange": 0},
+      "rtt": {"min": 37, "max": 100319, "avg": 1120, "sum": 313600,
+        "cnt": 280, "stddev": 5934.75, "hdrsize": 13424, "p50": 110,
+        "p75": 150, "p90": 250, "p95": 501, "p99": 100351,
+        "p99_99": 100351, "outofrange": 0},
+      "toppars": {"test-0": {"topic": "test", "partition": 0}}
+    }
+  },
+  "topics": {
+    "test": {
+      "topic": "test", "age": 9060, "metadata_age": 9060,
+      "batchsize": {"min": 0, "max": 0, "avg": 0, "sum": 0, "cnt": 0},
+      "partitions": {
+        "0": {"partition": 0, "broker": 1, "leader": 1, "desired": true,
+          "unknown": false, "fetchq_cnt": 10, "fetchq_size": 1000,
+          "fetch_state": "active", "query_offset": -1001,
+          "next_offset": 1200, "app_offset": 1190, "stored_offset": 1190,
+          "committed_offset": 1100, "eof_offset": -1001, "lo_offset": 0,
+          "hi_offset": 1500, "ls_offset": 1500, "consumer_lag": 400,
+          "consumer_lag_stored": 310, "leader_epoch": 3, "rxmsgs": 1200},
+        "1": {"partition": 1, "broker": 1, "leader": 1, "desired": true,
+          "fetch_state": "active", "hi_offset": 50,
+          "committed_offset": 40, "consumer_lag": 10},
+        "2": {"partition": 2, "broker": -1, "leader": -1, "desired": true,
+          "fetch_state": "none", "consumer_lag": -1},
+        "-1": {"partition": -1, "broker": -1, "leader": -1,
+          "consumer_lag": -1}
+      }
+    }
+  },
+  "cgrp": {"state": "up", "stateage": 8997, "join_state": "steady",
+    "rebalance_age": 8993, "rebalance_cnt": 1,
+    "rebalance_reason": "group is rebalancing", "assignment_size": 3},
+  "tx": 320, "tx_bytes": 84283, "rx": 319, "rx_bytes": 1081962,
+  "txmsgs": 0, "txmsg_bytes": 0, "rxmsgs": 1200, "rxmsg_bytes": 900000
+}`
+
+// TestStatsParse tests parsing of the statistics document and
+// the consumer lag aggregate.
+func TestStatsParse(t *testing.T) {
+	stats, err := Stats{statsJSONSample}.Parse()
+	if err != nil {
+		t.Fatalf("Parse failed: %s", err)
+	}
+
+	if stats.Name != "rdkafka#consumer-1" || stats.Type != "consumer" ||
+		stats.RxMsgs != 1200 || stats.MsgMax != 100000 {
+		t.Errorf("Unexpected client-level statistics: %+v", stats)
+	}
+
+	b, found := stats.Brokers["localhost:9092/1"]
+	if !found {
+		t.Fatalf("Broker not found: %+v", stats.Brokers)
+	}
+	if b.NodeID != 1 || b.State != "UP" || b.Req["Fetch"] != 312 ||
+		b.Rtt.P99 != 100351 || b.Rtt.Stddev != 5934.75 ||
+		b.IntLatency.HdrSize != 11376 ||
+		b.TopPars["test-0"].Topic != "test" {
+		t.Errorf("Unexpected broker statistics: %+v", b)
+	}
+
+	p := stats.Topics["test"].Partitions["0"]
+	if p.FetchState != "active" || p.CommittedOffset != 1100 ||
+		p.ConsumerLag != 400 || p.ConsumerLagStored != 310 ||
+		p.LeaderEpoch != 3 || !p.Desired {
+		t.Errorf("Unexpected partition statistics: %+v", p)
+	}
+
+	if stats.Cgrp == nil || stats.Cgrp.RebalanceCnt != 1 ||
+		stats.Cgrp.AssignmentSize != 3 {
+		t.Errorf("Unexpected cgrp statistics: %+v", stats.Cgrp)
+	}
+	if stats.EOS != nil {
+		t.Errorf("Consumer should not have eos statistics: %+v", stats.EOS)
+	}
+
+	// Unknown lags (-1) and the internal unassigned partition are ignored.
+	lag := stats.ConsumerLag()
+	if len(lag) != 1 || lag["test"] != 410 {
+		t.Errorf("Expected consumer lag 410 for topic test, got %v", lag)
+	}
+
+	_, err = Stats{"{not json"}.Parse()
+	if err == nil || err.(Error).Code() != ErrBadMsg {
+		t.Errorf("Expected ErrBadMsg for invalid statistics, got %v", err)
+	}
+}
+
+// TestStatsCb dry-tests that go.stats.cb is called with each Stats event,
+// which is still returned to the application.
+func TestStatsCb(t *testing.T) {
+	cbStats := make(chan *Stats, 100)
+
+	p, err := NewProducer(&ConfigMap{
+		"statistics.interval.ms": 50,
+		"socket.timeout.ms":      10,
+		"message.timeout.ms":     10,
+		"go.stats.cb":            func(stats *Stats) { cbStats <- stats }})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	defer p.Close()
+
+	select {
+	case stats := <-cbStats:
+		if _, err = stats.Parse(); err != nil {
+			t.Errorf("Failed to parse statistics: %s", err)
+		}
+	case <-time.After(time.Second * 3):
+		t.Fatalf("Expected go.stats.cb to be called")
+	}
+
+	select {
+	case ev := <-p.Events():
+		if _, ok := ev.(*Stats); !ok {
+			t.Errorf("Expected Stats event, got %v", ev)
+		}
+	case <-time.After(time.Second * 3):
+		t.Fatalf("Expected stats event with go.stats.cb")
+	}
+
+	_, err = NewProducer(&ConfigMap{"go.stats.cb": "not a function"})
+	if err == nil || err.(Error).Code() != ErrInvalidArg {
+		t.Errorf("Expected ErrInvalidArg for invalid go.stats.cb, got %v", err)
+	}
+}
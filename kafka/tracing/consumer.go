@@ -0,0 +1,163 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Consumer wraps a kafka.Consumer, tracing consumed messages.
+//
+// Each message returned by Poll(), PollContext(), ReadMessage(),
+// ReadMessageContext() or ReadBatch() gets a receive span, covering the
+// call, linked to the span that produced the message.
+// The processing of a message is traced with StartProcessSpan().
+type Consumer struct {
+	*kafka.Consumer
+
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+	groupID    string
+}
+
+// NewConsumer creates a new Consumer tracing the messages consumed with c.
+func NewConsumer(c *kafka.Consumer, config Config) *Consumer {
+	tc := &Consumer{
+		Consumer: c,
+		groupID:  config.ConsumerGroupID,
+	}
+	tc.tracer, tc.propagator = config.tracer()
+
+	return tc
+}
+
+// Poll polls the consumer for messages or events, see
+// kafka.Consumer.Poll(), recording a receive span for messages.
+func (c *Consumer) Poll(timeoutMs int) kafka.Event {
+	start := time.Now()
+	ev := c.Consumer.Poll(timeoutMs)
+	if msg, ok := ev.(*kafka.Message); ok {
+		c.received(start, msg, msg.TopicPartition.Error)
+	}
+	return ev
+}
+
+// ReadMessage polls the consumer for a message, see
+// kafka.Consumer.ReadMessage(), recording a receive span for the message.
+func (c *Consumer) ReadMessage(timeout time.Duration) (*kafka.Message, error) {
+	start := time.Now()
+	msg, err := c.Consumer.ReadMessage(timeout)
+	if msg != nil {
+		c.received(start, msg, err)
+	}
+	return msg, err
+}
+
+// PollContext polls the consumer for messages or events, see
+// kafka.Consumer.PollContext(), recording a receive span for messages.
+func (c *Consumer) PollContext(ctx context.Context) (kafka.Event, error) {
+	start := time.Now()
+	ev, err := c.Consumer.PollContext(ctx)
+	if msg, ok := ev.(*kafka.Message); ok {
+		c.received(start, msg, msg.TopicPartition.Error)
+	}
+	return ev, err
+}
+
+// ReadMessageContext polls the consumer for a message, see
+// kafka.Consumer.ReadMessageContext(), recording a receive span for the
+// message.
+func (c *Consumer) ReadMessageContext(ctx context.Context) (*kafka.Message, error) {
+	start := time.Now()
+	msg, err := c.Consumer.ReadMessageContext(ctx)
+	if msg != nil {
+		c.received(start, msg, err)
+	}
+	return msg, err
+}
+
+// ReadBatch polls the consumer for up to maxMessages messages, see
+// kafka.Consumer.ReadBatch(), recording a receive span for each message.
+func (c *Consumer) ReadBatch(ctx context.Context, maxMessages int, timeout time.Duration) ([]*kafka.Message, kafka.Event, error) {
+	start := time.Now()
+	msgs, ev, err := c.Consumer.ReadBatch(ctx, maxMessages, timeout)
+	for _, msg := range msgs {
+		c.received(start, msg, msg.TopicPartition.Error)
+	}
+	return msgs, ev, err
+}
+
+// received records the receive span of msg, started at start.
+func (c *Consumer) received(start time.Time, msg *kafka.Message, err error) {
+	_, span := c.tracer.Start(context.Background(), spanName(msg, operationReceive),
+		trace.WithTimestamp(start),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithLinks(producerLink(c.Extract(context.Background(), msg))...),
+		trace.WithAttributes(c.attributes(msg, operationReceive)...))
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
+
+// Extract returns ctx with the trace context and baggage extracted from
+// msg's headers.
+func (c *Consumer) Extract(ctx context.Context, msg *kafka.Message) context.Context {
+	return extract(ctx, c.propagator, msg)
+}
+
+// StartProcessSpan starts a process span for msg, a child of the span in ctx,
+// if any, and linked to the span that produced msg.
+// The returned context holds the span and the baggage of msg.
+// The caller must end the span once msg is processed.
+func (c *Consumer) StartProcessSpan(ctx context.Context, msg *kafka.Message) (context.Context, trace.Span) {
+	producerCtx := c.Extract(context.Background(), msg)
+	ctx = baggage.ContextWithBaggage(ctx, baggage.FromContext(producerCtx))
+
+	return c.tracer.Start(ctx, spanName(msg, operationProcess),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithLinks(producerLink(producerCtx)...),
+		trace.WithAttributes(c.attributes(msg, operationProcess)...))
+}
+
+// producerLink returns the link to the producer span extracted into
+// producerCtx, if any.
+func producerLink(producerCtx context.Context) []trace.Link {
+	sc := trace.SpanContextFromContext(producerCtx)
+	if !sc.IsValid() {
+		return nil
+	}
+	return []trace.Link{{SpanContext: sc}}
+}
+
+// attributes returns the span attributes of msg.
+func (c *Consumer) attributes(msg *kafka.Message, operation attribute.KeyValue) []attribute.KeyValue {
+	attrs := append(messageAttributes(msg, operation),
+		semconv.MessagingKafkaMessageOffset(int(msg.TopicPartition.Offset)))
+	if c.groupID != "" {
+		attrs = append(attrs, semconv.MessagingKafkaConsumerGroup(c.groupID))
+	}
+	return attrs
+}
@@ -0,0 +1,212 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Producer wraps a kafka.Producer, tracing produced messages.
+//
+// Each message produced with Produce(), ProduceContext() or
+// ProduceChannel() gets a producer span, ended when its delivery report is
+// received, and the span's trace context is injected into its headers.
+// The delivery reports are returned, with the message's original Opaque,
+// on the deliveryChan passed to Produce(), or on Events().
+// Delivery reports must not be disabled (`go.delivery.reports=false`)
+// for the spans to end.
+//
+// The Producer takes ownership of the kafka.Producer: use Close()
+// rather than closing the kafka.Producer.
+type Producer struct {
+	*kafka.Producer
+
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+
+	// deliveries receives the delivery reports of traced messages
+	deliveries     chan kafka.Event
+	events         chan kafka.Event
+	produceChannel chan *kafka.Message
+	// channelProducerDone is closed once the produce channel is served
+	channelProducerDone chan struct{}
+	eventForwarderDone  chan struct{}
+	closeOnce           sync.Once
+}
+
+// tracedOpaque replaces the Opaque of a traced message until its
+// delivery report is received.
+type tracedOpaque struct {
+	span         trace.Span
+	deliveryChan chan kafka.Event
+	opaque       interface{}
+}
+
+// NewProducer creates a new Producer tracing the messages produced with p.
+func NewProducer(p *kafka.Producer, config Config) *Producer {
+	tp := &Producer{
+		Producer:       p,
+		deliveries:     make(chan kafka.Event, 1000),
+		events:         make(chan kafka.Event, cap(p.Events())),
+		produceChannel: make(chan *kafka.Message, cap(p.ProduceChannel())),
+
+		channelProducerDone: make(chan struct{}),
+		eventForwarderDone:  make(chan struct{}),
+	}
+	tp.tracer, tp.propagator = config.tracer()
+
+	go tp.eventForwarder()
+	go tp.channelProducer()
+
+	return tp
+}
+
+// Events returns the Events channel, which receives the underlying
+// kafka.Producer's events and the delivery reports of traced messages
+// produced without a deliveryChan.
+func (p *Producer) Events() chan kafka.Event {
+	return p.events
+}
+
+// ProduceChannel returns the traced produce channel.
+// Messages that fail to be produced are returned on Events() with
+// TopicPartition.Error set.
+func (p *Producer) ProduceChannel() chan *kafka.Message {
+	return p.produceChannel
+}
+
+// Produce produces msg, see kafka.Producer.Produce(), in a new
+// producer span that is not part of any trace.
+func (p *Producer) Produce(msg *kafka.Message, deliveryChan chan kafka.Event) error {
+	return p.ProduceContext(context.Background(), msg, deliveryChan)
+}
+
+// ProduceContext produces msg, see kafka.Producer.Produce(), in a new
+// producer span that is a child of the span in ctx, if any.
+// The span's trace context and ctx's baggage are injected into
+// msg's headers.
+func (p *Producer) ProduceContext(ctx context.Context, msg *kafka.Message, deliveryChan chan kafka.Event) error {
+	ctx, span := p.tracer.Start(ctx, spanName(msg, operationPublish),
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(messageAttributes(msg, operationPublish)...))
+
+	p.propagator.Inject(ctx, NewMessageCarrier(msg))
+
+	opaque := msg.Opaque
+	msg.Opaque = &tracedOpaque{span: span, deliveryChan: deliveryChan, opaque: opaque}
+	err := p.Producer.Produce(msg, p.deliveries)
+	msg.Opaque = opaque
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
+	}
+
+	return err
+}
+
+// delivered ends the span of the delivery report m and returns it to the
+// application.
+func (p *Producer) delivered(m *kafka.Message) {
+	traced, ok := m.Opaque.(*tracedOpaque)
+	if !ok {
+		p.events <- m
+		return
+	}
+	m.Opaque = traced.opaque
+
+	span := traced.span
+	span.SetAttributes(
+		semconv.MessagingKafkaDestinationPartition(int(m.TopicPartition.Partition)))
+	if m.TopicPartition.Error != nil {
+		span.RecordError(m.TopicPartition.Error)
+		span.SetStatus(codes.Error, m.TopicPartition.Error.Error())
+	} else {
+		span.SetAttributes(
+			semconv.MessagingKafkaMessageOffset(int(m.TopicPartition.Offset)))
+	}
+	span.End()
+
+	if traced.deliveryChan != nil {
+		traced.deliveryChan <- m
+	} else {
+		p.events <- m
+	}
+}
+
+// eventForwarder serves the delivery reports of traced messages and
+// forwards the kafka.Producer's events to Events().
+func (p *Producer) eventForwarder() {
+	defer close(p.eventForwarderDone)
+	defer close(p.events)
+
+	events := p.Producer.Events()
+	deliveries := p.deliveries
+	for events != nil || deliveries != nil {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			p.events <- ev
+
+		case ev, ok := <-deliveries:
+			if !ok {
+				deliveries = nil
+				continue
+			}
+			p.delivered(ev.(*kafka.Message))
+		}
+	}
+}
+
+// channelProducer serves the ProduceChannel channel.
+func (p *Producer) channelProducer() {
+	defer close(p.channelProducerDone)
+
+	for m := range p.produceChannel {
+		err := p.Produce(m, nil)
+		if err != nil {
+			m.TopicPartition.Error = err
+			p.deliveries <- m
+		}
+	}
+}
+
+// Close closes the Producer and the underlying kafka.Producer.
+// The Producer object or its channels are no longer usable after this call.
+func (p *Producer) Close() {
+	p.closeOnce.Do(func() {
+		close(p.produceChannel)
+		<-p.channelProducerDone
+
+		p.Producer.Close()
+
+		// No delivery reports are received once the kafka.Producer is closed.
+		close(p.deliveries)
+		<-p.eventForwarderDone
+	})
+}
@@ -0,0 +1,163 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package tracing provides OpenTelemetry instrumentation for Producer and
+// Consumer instances.
+//
+// The Producer wrapper injects the W3C trace context (traceparent and
+// tracestate) and baggage of each produced message into its headers, and
+// records a producer span that ends when the message's delivery report is
+// received.
+// The Consumer wrapper extracts the trace context of consumed messages and
+// records receive and process spans linked to the producer span.
+// Spans follow the OpenTelemetry messaging semantic conventions.
+package tracing
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name of the tracer
+const instrumentationName = "github.com/confluentinc/confluent-kafka-go/v2/kafka/tracing"
+
+// Messaging operations.
+// "process" supersedes the "deliver" operation of semantic conventions v1.24.0.
+var (
+	operationPublish = semconv.MessagingOperationPublish
+	operationReceive = semconv.MessagingOperationReceive
+	operationProcess = semconv.MessagingOperationKey.String("process")
+)
+
+// Config holds the tracing configuration.
+type Config struct {
+	// TracerProvider creates the tracer (default otel.GetTracerProvider()).
+	TracerProvider trace.TracerProvider
+	// Propagator injects and extracts the trace context and baggage
+	// to and from message headers
+	// (default W3C trace context and W3C baggage).
+	Propagator propagation.TextMapPropagator
+	// ConsumerGroupID is the consumer's `group.id`, recorded on consumer spans.
+	ConsumerGroupID string
+}
+
+// tracer returns the tracer and propagator of the configuration.
+func (config Config) tracer() (trace.Tracer, propagation.TextMapPropagator) {
+	tp := config.TracerProvider
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+
+	propagator := config.Propagator
+	if propagator == nil {
+		propagator = propagation.NewCompositeTextMapPropagator(
+			propagation.TraceContext{}, propagation.Baggage{})
+	}
+
+	return tp.Tracer(instrumentationName), propagator
+}
+
+// MessageCarrier adapts the headers of a Message to a
+// propagation.TextMapCarrier.
+type MessageCarrier struct {
+	msg *kafka.Message
+}
+
+var _ propagation.TextMapCarrier = MessageCarrier{}
+
+// NewMessageCarrier creates a new MessageCarrier for msg's headers.
+func NewMessageCarrier(msg *kafka.Message) MessageCarrier {
+	return MessageCarrier{msg: msg}
+}
+
+// Get returns the value of the last header with key, or "" if not found.
+func (c MessageCarrier) Get(key string) string {
+	for i := len(c.msg.Headers) - 1; i >= 0; i-- {
+		if c.msg.Headers[i].Key == key {
+			return string(c.msg.Headers[i].Value)
+		}
+	}
+	return ""
+}
+
+// Set sets the header key to value, replacing any existing header with key,
+// e.g., the trace context of a consumed message that is produced again.
+func (c MessageCarrier) Set(key string, value string) {
+	// The headers may be shared with other messages, build a new slice
+	// rather than filtering them in place.
+	headers := make([]kafka.Header, 0, len(c.msg.Headers)+1)
+	for _, h := range c.msg.Headers {
+		if h.Key != key {
+			headers = append(headers, h)
+		}
+	}
+	c.msg.Headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+// Keys returns the keys of the headers.
+func (c MessageCarrier) Keys() []string {
+	keys := make([]string, len(c.msg.Headers))
+	for i, h := range c.msg.Headers {
+		keys[i] = h.Key
+	}
+	return keys
+}
+
+// messageAttributes returns the semantic convention attributes of msg.
+func messageAttributes(msg *kafka.Message, operation attribute.KeyValue) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		semconv.MessagingSystemKafka,
+		operation,
+		semconv.MessagingMessageBodySize(len(msg.Value)),
+	}
+
+	if msg.TopicPartition.Topic != nil {
+		attrs = append(attrs, semconv.MessagingDestinationName(*msg.TopicPartition.Topic))
+	}
+	if msg.TopicPartition.Partition != kafka.PartitionAny {
+		attrs = append(attrs, semconv.MessagingKafkaDestinationPartition(int(msg.TopicPartition.Partition)))
+	}
+	// Binary keys are not recorded
+	if msg.Key != nil && utf8.Valid(msg.Key) {
+		attrs = append(attrs, semconv.MessagingKafkaMessageKey(string(msg.Key)))
+	}
+	if msg.Value == nil {
+		attrs = append(attrs, semconv.MessagingKafkaMessageTombstone(true))
+	}
+
+	return attrs
+}
+
+// spanName returns the name of a span of operation on msg's topic.
+func spanName(msg *kafka.Message, operation attribute.KeyValue) string {
+	topic := "(anonymous)"
+	if msg.TopicPartition.Topic != nil {
+		topic = *msg.TopicPartition.Topic
+	}
+	return topic + " " + operation.Value.AsString()
+}
+
+// extract returns ctx with the trace context and baggage of msg's headers.
+func extract(ctx context.Context, propagator propagation.TextMapPropagator, msg *kafka.Message) context.Context {
+	return propagator.Extract(ctx, NewMessageCarrier(msg))
+}
@@ -0,0 +1,307 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMessageCarrier(t *testing.T) {
+	assert := assert.New(t)
+
+	msg := &kafka.Message{Headers: []kafka.Header{
+		{Key: "app", Value: []byte("value")},
+		{Key: "traceparent", Value: []byte("old")},
+	}}
+	carrier := NewMessageCarrier(msg)
+
+	assert.Equal("old", carrier.Get("traceparent"))
+	assert.Equal("", carrier.Get("tracestate"))
+
+	carrier.Set("traceparent", "new")
+	carrier.Set("tracestate", "state")
+	assert.Equal([]string{"app", "traceparent", "tracestate"}, carrier.Keys())
+	assert.Equal("new", carrier.Get("traceparent"))
+	assert.Equal("state", carrier.Get("tracestate"))
+
+	// Headers shared by several messages are not modified.
+	shared := []kafka.Header{
+		{Key: "traceparent", Value: []byte("old")},
+		{Key: "app", Value: []byte("value")},
+	}
+	msg1 := &kafka.Message{Headers: shared}
+	msg2 := &kafka.Message{Headers: shared}
+	NewMessageCarrier(msg1).Set("traceparent", "new")
+	assert.Equal([]string{"app", "traceparent"}, NewMessageCarrier(msg1).Keys())
+	assert.Equal("new", NewMessageCarrier(msg1).Get("traceparent"))
+	assert.Equal([]string{"traceparent", "app"}, NewMessageCarrier(msg2).Keys())
+	assert.Equal("old", NewMessageCarrier(msg2).Get("traceparent"))
+	assert.Equal("value", NewMessageCarrier(msg2).Get("app"))
+}
+
+// spanAttributes returns the attributes of span as a map.
+func spanAttributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+// TestTracingPropagation tests that the trace context and baggage are
+// propagated from the producer span to the consumer spans.
+func TestTracingPropagation(t *testing.T) {
+	assert := assert.New(t)
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer tp.Shutdown(context.Background())
+
+	mockCluster, err := kafka.NewMockCluster(1)
+	assert.NoError(err, "Mock cluster creation should succeed")
+	defer mockCluster.Close()
+
+	topic := "orders"
+	assert.NoError(mockCluster.CreateTopic(topic, 1, 1))
+
+	kp, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+	})
+	assert.NoError(err, "Producer creation should succeed")
+	p := NewProducer(kp, Config{TracerProvider: tp})
+	defer p.Close()
+
+	// Produce within a parent span, with baggage.
+	member, err := baggage.NewMember("tenant", "acme")
+	assert.NoError(err)
+	bag, err := baggage.New(member)
+	assert.NoError(err)
+	ctx, parent := tp.Tracer("test").Start(
+		baggage.ContextWithBaggage(context.Background(), bag), "parent")
+
+	deliveryChan := make(chan kafka.Event, 1)
+	err = p.ProduceContext(ctx, &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+		Key:            []byte("order-1"),
+		Value:          []byte("value"),
+		Opaque:         "opaque",
+	}, deliveryChan)
+	assert.NoError(err)
+	parent.End()
+
+	var dr *kafka.Message
+	select {
+	case ev := <-deliveryChan:
+		dr = ev.(*kafka.Message)
+	case <-time.After(10 * time.Second):
+		t.Fatalf("Expected delivery report")
+	}
+	assert.NoError(dr.TopicPartition.Error)
+	assert.Equal("opaque", dr.Opaque, "The original Opaque should be returned")
+
+	spans := exporter.GetSpans()
+	if !assert.Len(spans, 2, "The producer span should end on delivery") {
+		return
+	}
+	publish := spans[0]
+	if publish.Name == "parent" {
+		publish = spans[1]
+	}
+	assert.Equal("orders publish", publish.Name)
+	assert.Equal(trace.SpanKindProducer, publish.SpanKind)
+	assert.Equal(parent.SpanContext().SpanID(), publish.Parent.SpanID())
+	attrs := spanAttributes(publish)
+	assert.Equal("kafka", attrs[semconv.MessagingSystemKey].AsString())
+	assert.Equal("orders", attrs[semconv.MessagingDestinationNameKey].AsString())
+	assert.Equal("order-1", attrs[semconv.MessagingKafkaMessageKeyKey].AsString())
+	assert.Equal(int64(0), attrs[semconv.MessagingKafkaDestinationPartitionKey].AsInt64())
+	assert.Equal(int64(dr.TopicPartition.Offset), attrs[semconv.MessagingKafkaMessageOffsetKey].AsInt64())
+	exporter.Reset()
+
+	kc, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+		"group.id":          "tracing",
+		"auto.offset.reset": "earliest",
+	})
+	assert.NoError(err, "Consumer creation should succeed")
+	defer kc.Close()
+	c := NewConsumer(kc, Config{TracerProvider: tp, ConsumerGroupID: "tracing"})
+	assert.NoError(c.Subscribe(topic, nil))
+
+	msg, err := c.ReadMessage(10 * time.Second)
+	if !assert.NoError(err) {
+		return
+	}
+	assert.NotEmpty(NewMessageCarrier(msg).Get("traceparent"))
+
+	processCtx, process := c.StartProcessSpan(context.Background(), msg)
+	assert.Equal("acme", baggage.FromContext(processCtx).Member("tenant").Value(),
+		"The baggage should be propagated")
+	process.End()
+
+	spans = exporter.GetSpans()
+	if !assert.Len(spans, 2) {
+		return
+	}
+	for i, name := range []string{"orders receive", "orders process"} {
+		span := spans[i]
+		assert.Equal(name, span.Name)
+		assert.Equal(trace.SpanKindConsumer, span.SpanKind)
+		if assert.Len(span.Links, 1, "%s should be linked to the producer span", name) {
+			assert.Equal(publish.SpanContext.TraceID(), span.Links[0].SpanContext.TraceID())
+			assert.Equal(publish.SpanContext.SpanID(), span.Links[0].SpanContext.SpanID())
+		}
+		attrs = spanAttributes(span)
+		assert.Equal("tracing", attrs[semconv.MessagingKafkaConsumerGroupKey].AsString())
+		assert.Equal(int64(dr.TopicPartition.Offset), attrs[semconv.MessagingKafkaMessageOffsetKey].AsInt64())
+	}
+}
+
+// TestTracingConsumerBatch tests the receive spans of the messages
+// returned by ReadBatch() and ReadMessageContext().
+func TestTracingConsumerBatch(t *testing.T) {
+	assert := assert.New(t)
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer tp.Shutdown(context.Background())
+
+	mockCluster, err := kafka.NewMockCluster(1)
+	assert.NoError(err, "Mock cluster creation should succeed")
+	defer mockCluster.Close()
+
+	topic := "orders"
+	assert.NoError(mockCluster.CreateTopic(topic, 1, 1))
+
+	kp, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+	})
+	assert.NoError(err, "Producer creation should succeed")
+	p := NewProducer(kp, Config{TracerProvider: tp})
+	defer p.Close()
+
+	msgcnt := 3
+	deliveryChan := make(chan kafka.Event, msgcnt)
+	for i := 0; i < msgcnt; i++ {
+		assert.NoError(p.ProduceContext(context.Background(), &kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 0},
+			Value:          []byte("value"),
+		}, deliveryChan))
+	}
+	for i := 0; i < msgcnt; i++ {
+		select {
+		case ev := <-deliveryChan:
+			assert.NoError(ev.(*kafka.Message).TopicPartition.Error)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Expected delivery report")
+		}
+	}
+	publishes := exporter.GetSpans()
+	if !assert.Len(publishes, msgcnt) {
+		return
+	}
+	exporter.Reset()
+
+	kc, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+		"group.id":          "tracing",
+		"auto.offset.reset": "earliest",
+	})
+	assert.NoError(err, "Consumer creation should succeed")
+	defer kc.Close()
+	c := NewConsumer(kc, Config{TracerProvider: tp, ConsumerGroupID: "tracing"})
+	assert.NoError(c.Subscribe(topic, nil))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var msgs []*kafka.Message
+	for len(msgs) < msgcnt-1 && ctx.Err() == nil {
+		batch, _, _ := c.ReadBatch(ctx, msgcnt-1-len(msgs), time.Second)
+		msgs = append(msgs, batch...)
+	}
+	msg, err := c.ReadMessageContext(ctx)
+	if !assert.NoError(err) {
+		return
+	}
+	msgs = append(msgs, msg)
+
+	spans := exporter.GetSpans()
+	if !assert.Len(spans, msgcnt) {
+		return
+	}
+	for i, span := range spans {
+		assert.Equal("orders receive", span.Name)
+		assert.Equal(int64(msgs[i].TopicPartition.Offset),
+			spanAttributes(span)[semconv.MessagingKafkaMessageOffsetKey].AsInt64())
+		if assert.Len(span.Links, 1, "The receive span should be linked to the producer span") {
+			assert.Equal(publishes[i].SpanContext.SpanID(), span.Links[0].SpanContext.SpanID())
+		}
+	}
+}
+
+// TestTracingProduceError tests that the producer span ends with an error
+// status when the message fails to be delivered.
+func TestTracingProduceError(t *testing.T) {
+	assert := assert.New(t)
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer tp.Shutdown(context.Background())
+
+	kp, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers":  "127.0.0.1:65533",
+		"message.timeout.ms": 10,
+	})
+	assert.NoError(err)
+	p := NewProducer(kp, Config{TracerProvider: tp})
+	defer p.Close()
+
+	topic := "orders"
+	p.ProduceChannel() <- &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+	}
+
+	// The delivery report is returned on Events().
+	timeout := time.After(10 * time.Second)
+	for dr := (*kafka.Message)(nil); dr == nil; {
+		select {
+		case ev := <-p.Events():
+			dr, _ = ev.(*kafka.Message)
+		case <-timeout:
+			t.Fatalf("Expected delivery report")
+		}
+	}
+
+	spans := exporter.GetSpans()
+	if assert.Len(spans, 1) {
+		assert.Equal(codes.Error, spans[0].Status.Code)
+		assert.True(spanAttributes(spans[0])[semconv.MessagingKafkaMessageTombstoneKey].AsBool())
+	}
+}
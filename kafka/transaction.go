@@ -0,0 +1,218 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// txnRetryBackoff is the time to wait before retrying a transactional
+// API call that failed with a retriable error.
+const txnRetryBackoff = 100 * time.Millisecond
+
+// Transaction is the ongoing transaction of a RunInTransaction() callback.
+type Transaction struct {
+	p   *Producer
+	ctx context.Context
+}
+
+// Producer returns the transactional producer.
+func (txn *Transaction) Producer() *Producer {
+	return txn.p
+}
+
+// Context returns the context passed to RunInTransaction().
+func (txn *Transaction) Context() context.Context {
+	return txn.ctx
+}
+
+// Produce produces msg as part of the transaction, see Producer.Produce().
+func (txn *Transaction) Produce(msg *Message, deliveryChan chan Event) error {
+	return txn.p.Produce(msg, deliveryChan)
+}
+
+// SendOffsets marks the consumer offsets as part of the transaction, to be
+// committed with it, see Producer.SendOffsetsToTransaction().
+// Retriable errors are retried until the context passed to
+// RunInTransaction() is done.
+func (txn *Transaction) SendOffsets(offsets []TopicPartition, consumerMetadata *ConsumerGroupMetadata) error {
+	return txn.p.RetryTransactional(txn.ctx, func() error {
+		return txn.p.SendOffsetsToTransaction(txn.ctx, offsets, consumerMetadata)
+	})
+}
+
+// SendConsumerOffsets marks the current position of the partitions assigned
+// to the consumer c as part of the transaction, as SendOffsets().
+// The consumer must have `enable.auto.commit=false`.
+func (txn *Transaction) SendConsumerOffsets(c *Consumer) error {
+	assignment, err := c.Assignment()
+	if err != nil {
+		return err
+	}
+
+	position, err := c.Position(assignment)
+	if err != nil {
+		return err
+	}
+
+	consumerMetadata, err := c.GetConsumerGroupMetadata()
+	if err != nil {
+		return err
+	}
+
+	return txn.SendOffsets(position, consumerMetadata)
+}
+
+// RunInTransaction runs fn in a new transaction of the transactional
+// producer, committing the transaction if fn returns nil and aborting it
+// otherwise.
+//
+// `InitTransactions()` must have been called successfully (once) before
+// this function is called, and no other transaction may be ongoing.
+//
+// fn produces the messages of the transaction, and attaches the consumer
+// offsets of a consume-transform-produce loop, with txn.
+//
+// Parameters:
+//   - `ctx` - The maximum amount of time to block, or nil for indefinite.
+//     Retriable errors of the transactional API calls are retried until
+//     `ctx` is done.
+//   - `fn` - The function producing the messages of the transaction.
+//
+// Note: The application MUST serve the `producer.Events()` channel for
+// delivery reports in a separate go-routine, as for `CommitTransaction()`.
+//
+// Returns nil if the transaction was committed. Otherwise the transaction
+// was aborted, when possible, and the error of fn or of the transactional
+// API call is returned. The abort is bounded by `transaction.timeout.ms`
+// rather than `ctx`, which may be done already.
+// Abortable errors, with `err.(kafka.Error).TxnRequiresAbort()`, are
+// returned once the transaction is aborted: the producer may run a new
+// transaction.
+// If the abort fails a *TxnAbortError is returned, holding both errors,
+// and the transaction may still be ongoing.
+// If a fatal error has been raised, as checked by calling
+// `err.(kafka.Error).IsFatal()`, the fatal error is returned and the
+// producer must be closed and recreated.
+func (p *Producer) RunInTransaction(ctx context.Context, fn func(txn *Transaction) error) error {
+	err := p.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	err = fn(&Transaction{p: p, ctx: ctx})
+	if err != nil {
+		return p.abortRunningTransaction(err)
+	}
+
+	err = p.RetryTransactional(ctx, func() error {
+		return p.CommitTransaction(ctx)
+	})
+	if err != nil {
+		return p.abortRunningTransaction(err)
+	}
+
+	return nil
+}
+
+// TxnAbortError is returned by RunInTransaction() when the transaction
+// failed with Err and could not be aborted, the abort failing with
+// AbortErr.
+//
+// The transaction may still be ongoing: the application must retry
+// AbortTransaction() before running a new transaction, or close the
+// producer if AbortErr is fatal.
+type TxnAbortError struct {
+	// Err is the error of the transaction.
+	Err error
+	// AbortErr is the error of AbortTransaction().
+	AbortErr error
+}
+
+func (e *TxnAbortError) Error() string {
+	return fmt.Sprintf("%s (failed to abort transaction: %s)", e.Err, e.AbortErr)
+}
+
+// Unwrap returns Err and AbortErr, for errors.Is() and errors.As().
+func (e *TxnAbortError) Unwrap() []error {
+	return []error{e.Err, e.AbortErr}
+}
+
+// abortRunningTransaction aborts the transaction of RunInTransaction() that
+// failed with err, unless err is fatal.
+func (p *Producer) abortRunningTransaction(err error) error {
+	timeout := time.Duration(p.handle.confGetInt("transaction.timeout.ms", 60000)) * time.Millisecond
+	return p.abortTxn(err, timeout, p.AbortTransaction)
+}
+
+// abortTxn aborts the transaction that failed with err with abort, unless
+// err is fatal, retrying retriable errors for at most timeout.
+//
+// Returns err once aborted, else a *TxnAbortError.
+func (p *Producer) abortTxn(err error, timeout time.Duration, abort func(ctx context.Context) error) error {
+	if kerr, ok := err.(Error); ok && kerr.IsFatal() {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	abortErr := p.RetryTransactional(ctx, func() error {
+		return abort(ctx)
+	})
+	if abortErr != nil {
+		return &TxnAbortError{Err: err, AbortErr: abortErr}
+	}
+
+	return err
+}
+
+// RetryTransactional calls f, a transactional API call of the producer such
+// as `SendOffsetsToTransaction()`, `CommitTransaction()` or
+// `AbortTransaction()`, until it does not fail with a retriable error, as
+// checked by calling `err.(kafka.Error).IsRetriable()`, or `ctx` is done.
+// Retries are spaced by 100ms.
+//
+// This is the retry policy of RunInTransaction(), for applications that
+// drive the transactional API themselves.
+//
+// Parameters:
+//   - `ctx` - The maximum amount of time to retry, or nil for indefinite.
+//   - `f` - The transactional API call.
+//
+// Returns the last error of f, or nil.
+func (p *Producer) RetryTransactional(ctx context.Context, f func() error) error {
+	var done <-chan struct{}
+	if ctx != nil {
+		done = ctx.Done()
+	}
+
+	for {
+		err := f()
+		if kerr, ok := err.(Error); !ok || !kerr.IsRetriable() {
+			return err
+		}
+
+		select {
+		case <-done:
+			return err
+		case <-time.After(txnRetryBackoff):
+		}
+	}
+}
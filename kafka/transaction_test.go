@@ -0,0 +1,154 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// TestRetryTransactional tests the retries of retriable transactional errors
+func TestRetryTransactional(t *testing.T) {
+	p := &Producer{}
+	retriable := Error{code: ErrTimedOut, retriable: true}
+
+	calls := 0
+	err := p.RetryTransactional(nil, func() error {
+		calls++
+		if calls < 3 {
+			return retriable
+		}
+		return nil
+	})
+	if err != nil || calls != 3 {
+		t.Errorf("Expected success after 3 calls, got %v after %d calls", err, calls)
+	}
+
+	calls = 0
+	appErr := fmt.Errorf("application error")
+	err = p.RetryTransactional(nil, func() error {
+		calls++
+		return appErr
+	})
+	if err != appErr || calls != 1 {
+		t.Errorf("Expected non-retriable error after 1 call, got %v after %d calls", err, calls)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*txnRetryBackoff)
+	defer cancel()
+	start := time.Now()
+	err = p.RetryTransactional(ctx, func() error {
+		return retriable
+	})
+	if err != retriable {
+		t.Errorf("Expected the retriable error once the context is done, got %v", err)
+	}
+	if time.Since(start) > 10*txnRetryBackoff {
+		t.Errorf("Expected retries to stop with the context, took %v", time.Since(start))
+	}
+}
+
+// TestAbortTxn tests the abort of failed transactions
+func TestAbortTxn(t *testing.T) {
+	p := &Producer{}
+	txnErr := Error{code: ErrTimedOut, txnRequiresAbort: true}
+
+	// The abort runs with a fresh context, bounded by the timeout, and the
+	// error of the transaction is returned once aborted.
+	calls := 0
+	err := p.abortTxn(txnErr, time.Second, func(ctx context.Context) error {
+		calls++
+		if ctx.Err() != nil {
+			t.Errorf("The abort context should not be done, got %v", ctx.Err())
+		}
+		if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > time.Second {
+			t.Errorf("The abort context should be bounded by the timeout, got %v", deadline)
+		}
+		if calls < 2 {
+			return Error{code: ErrTimedOut, retriable: true}
+		}
+		return nil
+	})
+	if err != txnErr || calls != 2 {
+		t.Errorf("Expected %v after 2 abort calls, got %v after %d calls", txnErr, err, calls)
+	}
+
+	// A failed abort returns both errors.
+	abortErr := Error{code: ErrState}
+	err = p.abortTxn(txnErr, time.Second, func(ctx context.Context) error {
+		return abortErr
+	})
+	var txnAbortErr *TxnAbortError
+	if !errors.As(err, &txnAbortErr) {
+		t.Fatalf("Expected a TxnAbortError, got %v", err)
+	}
+	if txnAbortErr.Err != txnErr || txnAbortErr.AbortErr != abortErr {
+		t.Errorf("Expected errors %v and %v, got %v and %v",
+			txnErr, abortErr, txnAbortErr.Err, txnAbortErr.AbortErr)
+	}
+	if !errors.Is(err, txnErr) || !errors.Is(err, abortErr) {
+		t.Errorf("Expected %v to wrap both errors", err)
+	}
+
+	// A retriable abort error is retried until the timeout.
+	start := time.Now()
+	retriable := Error{code: ErrTimedOut, retriable: true}
+	err = p.abortTxn(txnErr, 3*txnRetryBackoff, func(ctx context.Context) error {
+		return retriable
+	})
+	if !errors.As(err, &txnAbortErr) || txnAbortErr.AbortErr != retriable {
+		t.Errorf("Expected a TxnAbortError with %v, got %v", retriable, err)
+	}
+	if time.Since(start) > 10*txnRetryBackoff {
+		t.Errorf("Expected the abort to stop at the timeout, took %v", time.Since(start))
+	}
+
+	// Fatal errors are not aborted.
+	fatal := Error{code: ErrFenced, fatal: true}
+	err = p.abortTxn(fatal, time.Second, func(ctx context.Context) error {
+		t.Errorf("Fatal errors should not be aborted")
+		return nil
+	})
+	if err != fatal {
+		t.Errorf("Expected %v, got %v", fatal, err)
+	}
+}
+
+// TestRunInTransactionNotInitialized dry-tests RunInTransaction() without
+// InitTransactions(), no broker is needed.
+func TestRunInTransactionNotInitialized(t *testing.T) {
+	p, err := NewProducer(&ConfigMap{"transactional.id": "test"})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	defer p.Close()
+
+	called := false
+	err = p.RunInTransaction(nil, func(txn *Transaction) error {
+		called = true
+		return nil
+	})
+	if err == nil || err.(Error).Code() != ErrState {
+		t.Errorf("Expected ErrState, got %v", err)
+	}
+	if called {
+		t.Errorf("Callback should not be called without a transaction")
+	}
+}
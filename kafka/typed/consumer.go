@@ -0,0 +1,250 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package typed
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry/serde"
+)
+
+// Record is a consumed message with a key of type K and a value of type V.
+type Record[K any, V any] struct {
+	// Key is the deserialized message key
+	Key K
+	// Value is the deserialized message value
+	Value V
+	// Headers are the message headers
+	Headers []kafka.Header
+	// TopicPartition the message was consumed from, including its offset
+	TopicPartition kafka.TopicPartition
+	// Timestamp of the message
+	Timestamp time.Time
+	// TimestampType of the message
+	TimestampType kafka.TimestampType
+	// KeySchema is the writer schema of the key, or nil if the key was not
+	// serialized with a schema registry schema.
+	KeySchema *schemaregistry.SchemaInfo
+	// ValueSchema is the writer schema of the value, or nil if the value was
+	// not serialized with a schema registry schema.
+	ValueSchema *schemaregistry.SchemaInfo
+	// Message is the raw message the record was deserialized from
+	Message *kafka.Message
+}
+
+// String returns a human readable representation of a Record
+func (r *Record[K, V]) String() string {
+	return fmt.Sprintf("Record on %s: key %v, value %v", r.TopicPartition, r.Key, r.Value)
+}
+
+// DeserializationError is returned when a consumed message's key or value
+// could not be deserialized.
+// The raw message is available in Message, e.g. for routing to a
+// dead-letter topic, and the consumer may continue to consume
+// subsequent messages.
+type DeserializationError struct {
+	// SerdeType is serde.KeySerde or serde.ValueSerde
+	SerdeType serde.Type
+	// Message is the raw message that could not be deserialized
+	Message *kafka.Message
+	// Err is the underlying deserializer error
+	Err error
+}
+
+func (e *DeserializationError) Error() string {
+	return fmt.Sprintf("failed to deserialize %s of message on %s: %v",
+		serdeTypeName(e.SerdeType), e.Message.TopicPartition, e.Err)
+}
+
+// String returns a human readable representation of a DeserializationError
+func (e *DeserializationError) String() string {
+	return e.Error()
+}
+
+// Unwrap returns the underlying deserializer error
+func (e *DeserializationError) Unwrap() error {
+	return e.Err
+}
+
+// schemaGetter is implemented by deserializers embedding
+// serde.BaseDeserializer.
+type schemaGetter interface {
+	GetSchema(topic string, payload []byte) (schemaregistry.SchemaInfo, error)
+}
+
+// Consumer consumes messages with keys of type K and values of type V,
+// deserialized by the configured serde.Deserializers, from an underlying
+// kafka.Consumer.
+type Consumer[K any, V any] struct {
+	consumer          *kafka.Consumer
+	keyDeserializer   serde.Deserializer
+	valueDeserializer serde.Deserializer
+}
+
+// NewConsumer creates a new typed Consumer wrapping consumer.
+//
+// keyDeserializer may be nil, in which case keys are not deserialized and
+// Record.Key is left as the zero value of K.
+// valueDeserializer is required.
+//
+// The typed Consumer does not take ownership of consumer or the
+// deserializers: the application remains responsible for closing them.
+func NewConsumer[K any, V any](consumer *kafka.Consumer, keyDeserializer serde.Deserializer,
+	valueDeserializer serde.Deserializer) (*Consumer[K, V], error) {
+	if consumer == nil {
+		return nil, kafka.NewError(kafka.ErrInvalidArg, "consumer must not be nil", false)
+	}
+	if valueDeserializer == nil {
+		return nil, kafka.NewError(kafka.ErrInvalidArg, "valueDeserializer must not be nil", false)
+	}
+
+	return &Consumer[K, V]{
+		consumer:          consumer,
+		keyDeserializer:   keyDeserializer,
+		valueDeserializer: valueDeserializer,
+	}, nil
+}
+
+// Consumer returns the underlying kafka.Consumer
+func (c *Consumer[K, V]) Consumer() *kafka.Consumer {
+	return c.consumer
+}
+
+// deserializeInto deserializes payload into a new T.
+// If T is a pointer type a new instance of the pointed-to type is
+// allocated and deserialized into, as expected by e.g. the protobuf
+// Deserializer, else a pointer to the T is passed to the deserializer.
+func deserializeInto[T any](deserializer serde.Deserializer, topic string, payload []byte) (T, error) {
+	var v T
+
+	target := interface{}(&v)
+	if rt := reflect.TypeOf(&v).Elem(); rt.Kind() == reflect.Pointer {
+		reflect.ValueOf(&v).Elem().Set(reflect.New(rt.Elem()))
+		target = v
+	}
+
+	err := deserializer.DeserializeInto(topic, payload, target)
+	return v, err
+}
+
+// writerSchema returns the writer schema of payload, if the deserializer
+// is schema registry based and the payload is framed with a schema ID,
+// else nil.
+func writerSchema(deserializer serde.Deserializer, topic string, payload []byte) *schemaregistry.SchemaInfo {
+	getter, ok := deserializer.(schemaGetter)
+	if !ok || len(payload) < 5 || payload[0] != serde.MagicByte {
+		return nil
+	}
+
+	info, err := getter.GetSchema(topic, payload)
+	if err != nil {
+		return nil
+	}
+	return &info
+}
+
+// Deserialize converts a consumed message to a Record.
+//
+// Returns a *DeserializationError if the key or value could not be
+// deserialized.
+func (c *Consumer[K, V]) Deserialize(msg *kafka.Message) (*Record[K, V], error) {
+	topic := ""
+	if msg.TopicPartition.Topic != nil {
+		topic = *msg.TopicPartition.Topic
+	}
+
+	r := &Record[K, V]{
+		Headers:        msg.Headers,
+		TopicPartition: msg.TopicPartition,
+		Timestamp:      msg.Timestamp,
+		TimestampType:  msg.TimestampType,
+		Message:        msg,
+	}
+
+	var err error
+	if c.keyDeserializer != nil {
+		r.Key, err = deserializeInto[K](c.keyDeserializer, topic, msg.Key)
+		if err != nil {
+			return nil, &DeserializationError{SerdeType: serde.KeySerde, Message: msg, Err: err}
+		}
+		r.KeySchema = writerSchema(c.keyDeserializer, topic, msg.Key)
+	}
+
+	r.Value, err = deserializeInto[V](c.valueDeserializer, topic, msg.Value)
+	if err != nil {
+		return nil, &DeserializationError{SerdeType: serde.ValueSerde, Message: msg, Err: err}
+	}
+	r.ValueSchema = writerSchema(c.valueDeserializer, topic, msg.Value)
+
+	return r, nil
+}
+
+// Poll polls the underlying consumer for messages or events.
+//
+// Will block for at most timeoutMs milliseconds.
+//
+// Messages are returned as *Record[K, V], or as *DeserializationError
+// if they could not be deserialized, while all other events are
+// returned as-is.
+//
+// Returns nil on timeout.
+func (c *Consumer[K, V]) Poll(timeoutMs int) kafka.Event {
+	ev := c.consumer.Poll(timeoutMs)
+
+	msg, ok := ev.(*kafka.Message)
+	if !ok || msg.TopicPartition.Error != nil {
+		return ev
+	}
+
+	r, err := c.Deserialize(msg)
+	if err != nil {
+		return err.(*DeserializationError)
+	}
+	return r
+}
+
+// ReadRecord polls the underlying consumer for a message and deserializes it.
+//
+// See kafka.Consumer.ReadMessage() for the timeout and error semantics.
+// In addition, messages that could not be deserialized are returned
+// as (nil, *DeserializationError); the application may continue to call
+// ReadRecord() to consume subsequent messages.
+func (c *Consumer[K, V]) ReadRecord(timeout time.Duration) (*Record[K, V], error) {
+	msg, err := c.consumer.ReadMessage(timeout)
+	if err != nil {
+		return nil, err
+	}
+	return c.Deserialize(msg)
+}
+
+// ReadRecordContext polls the underlying consumer for a message until one is
+// available or ctx is done, and deserializes it.
+//
+// See kafka.Consumer.ReadMessageContext() and ReadRecord() for the error
+// semantics.
+func (c *Consumer[K, V]) ReadRecordContext(ctx context.Context) (*Record[K, V], error) {
+	msg, err := c.consumer.ReadMessageContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Deserialize(msg)
+}
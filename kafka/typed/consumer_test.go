@@ -0,0 +1,174 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package typed
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry/serde"
+)
+
+// TestConsumerDeserialize verifies Record conversion and that
+// deserialization failures carry the raw message.
+func TestConsumerDeserialize(t *testing.T) {
+	c, err := kafka.NewConsumer(&kafka.ConfigMap{"group.id": "gotest"})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	defer c.Close()
+
+	_, err = NewConsumer[string, string](c, nil, nil)
+	if err == nil {
+		t.Errorf("Expected NewConsumer() to fail without a value deserializer")
+	}
+
+	tc, err := NewConsumer[string, *string](c, NewRawDeserializer(), NewRawDeserializer())
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	topic := "gotest"
+	msg := &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 3, Offset: 42},
+		Key:            []byte("key"),
+		Value:          []byte("value"),
+		Headers:        []kafka.Header{{Key: "hdr", Value: []byte("val")}},
+		Timestamp:      time.Unix(1700000000, 0),
+		TimestampType:  kafka.TimestampCreateTime,
+	}
+
+	r, err := tc.Deserialize(msg)
+	if err != nil {
+		t.Fatalf("Deserialize() failed: %s", err)
+	}
+	if r.Key != "key" || r.Value == nil || *r.Value != "value" ||
+		r.TopicPartition.Offset != 42 || r.TopicPartition.Partition != 3 ||
+		!r.Timestamp.Equal(msg.Timestamp) || len(r.Headers) != 1 ||
+		r.Message != msg || r.KeySchema != nil || r.ValueSchema != nil {
+		t.Errorf("Unexpected record %v", r)
+	}
+
+	// Unsupported target type
+	tci, err := NewConsumer[string, int](c, NewRawDeserializer(), NewRawDeserializer())
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	_, err = tci.Deserialize(msg)
+	var derr *DeserializationError
+	if !errors.As(err, &derr) {
+		t.Fatalf("Expected DeserializationError, got %v", err)
+	}
+	if derr.SerdeType != serde.ValueSerde || derr.Message != msg {
+		t.Errorf("Unexpected DeserializationError %v", derr)
+	}
+}
+
+// TestConsumerMockCluster round-trips typed records through a mock cluster
+// and verifies that a message that fails to deserialize does not stop
+// consumption.
+func TestConsumerMockCluster(t *testing.T) {
+	mc, err := kafka.NewMockCluster(1)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	defer mc.Close()
+
+	topic := "typed"
+	err = mc.CreateTopic(topic, 1, 1)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	p, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": mc.BootstrapServers()})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	defer p.Close()
+
+	tp, err := NewProducer[string, string](p, NewRawSerializer(), NewRawSerializer())
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for _, value := range []string{"first", "poison", "second"} {
+		_, err = tp.Produce(ctx, topic, value, value, nil)
+		if err != nil {
+			t.Fatalf("Produce(%s) failed: %s", value, err)
+		}
+	}
+
+	c, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers": mc.BootstrapServers(),
+		"group.id":          "typed",
+		"auto.offset.reset": "earliest",
+	})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	defer c.Close()
+
+	err = c.Subscribe(topic, nil)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	tc, err := NewConsumer[string, string](c, NewRawDeserializer(), &poisonDeserializer{})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	var values []string
+	var poisoned *kafka.Message
+	for i := 0; i < 3; i++ {
+		r, err := tc.ReadRecordContext(ctx)
+		var derr *DeserializationError
+		if errors.As(err, &derr) {
+			poisoned = derr.Message
+			continue
+		} else if err != nil {
+			t.Fatalf("ReadRecordContext() failed: %s", err)
+		}
+		values = append(values, r.Value)
+	}
+
+	if len(values) != 2 || values[0] != "first" || values[1] != "second" {
+		t.Errorf("Unexpected values %v", values)
+	}
+	if poisoned == nil || string(poisoned.Value) != "poison" {
+		t.Errorf("Expected poisoned message, got %v", poisoned)
+	}
+}
+
+// poisonDeserializer fails to deserialize the value "poison"
+type poisonDeserializer struct {
+	RawDeserializer
+}
+
+func (s *poisonDeserializer) DeserializeInto(topic string, payload []byte, msg interface{}) error {
+	if string(payload) == "poison" {
+		return errors.New("poisoned")
+	}
+	return s.RawDeserializer.DeserializeInto(topic, payload, msg)
+}
@@ -0,0 +1,124 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package typed
+
+import (
+	"context"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry/serde"
+)
+
+// Producer produces messages with keys of type K and values of type V,
+// serialized by the configured serde.Serializers, on an underlying
+// kafka.Producer.
+type Producer[K any, V any] struct {
+	producer        *kafka.Producer
+	keySerializer   serde.Serializer
+	valueSerializer serde.Serializer
+}
+
+// NewProducer creates a new typed Producer wrapping producer.
+//
+// keySerializer may be nil, in which case messages are produced without a key.
+// valueSerializer is required.
+//
+// The typed Producer does not take ownership of producer or the serializers:
+// the application remains responsible for closing them.
+func NewProducer[K any, V any](producer *kafka.Producer, keySerializer serde.Serializer,
+	valueSerializer serde.Serializer) (*Producer[K, V], error) {
+	if producer == nil {
+		return nil, kafka.NewError(kafka.ErrInvalidArg, "producer must not be nil", false)
+	}
+	if valueSerializer == nil {
+		return nil, kafka.NewError(kafka.ErrInvalidArg, "valueSerializer must not be nil", false)
+	}
+
+	return &Producer[K, V]{
+		producer:        producer,
+		keySerializer:   keySerializer,
+		valueSerializer: valueSerializer,
+	}, nil
+}
+
+// Producer returns the underlying kafka.Producer
+func (p *Producer[K, V]) Producer() *kafka.Producer {
+	return p.producer
+}
+
+// NewMessage serializes key and value and returns a kafka.Message for topic
+// that may be produced with the underlying kafka.Producer.
+//
+// Returns a *SerializationError if the key or value could not be serialized.
+func (p *Producer[K, V]) NewMessage(topic string, key K, value V, headers []kafka.Header) (*kafka.Message, error) {
+	msg := &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+		Headers:        headers,
+	}
+
+	var err error
+	if p.keySerializer != nil {
+		msg.Key, err = p.keySerializer.Serialize(topic, key)
+		if err != nil {
+			return nil, &SerializationError{Topic: topic, SerdeType: serde.KeySerde, Err: err}
+		}
+	}
+
+	msg.Value, err = p.valueSerializer.Serialize(topic, value)
+	if err != nil {
+		return nil, &SerializationError{Topic: topic, SerdeType: serde.ValueSerde, Err: err}
+	}
+
+	return msg, nil
+}
+
+// Produce serializes key and value, produces the resulting message to topic
+// and waits for its delivery report or for ctx to be done, whichever happens
+// first.
+//
+// Returns the delivered message on success.
+// Serialization failures are returned as (nil, *SerializationError),
+// local produce failures (e.g., a full queue) as (nil, kafka.Error) and
+// delivery failures as (msg, kafka.Error) where msg is the delivery report.
+// If ctx is done before the delivery report is received (nil, ctx.Err())
+// is returned; the message may still be delivered.
+func (p *Producer[K, V]) Produce(ctx context.Context, topic string, key K, value V, headers []kafka.Header) (*kafka.Message, error) {
+	msg, err := p.NewMessage(topic, key, value, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	// Buffered so that a late delivery report does not block
+	// the producer's poller when ctx is done first.
+	deliveryChan := make(chan kafka.Event, 1)
+
+	err = p.producer.Produce(msg, deliveryChan)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case e := <-deliveryChan:
+		m := e.(*kafka.Message)
+		if m.TopicPartition.Error != nil {
+			return m, m.TopicPartition.Error
+		}
+		return m, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,126 @@
+/**
+ * Copyright 2026 Confluent Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package typed
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry/serde"
+)
+
+// failingSerializer fails every Serialize() call
+type failingSerializer struct {
+	RawSerializer
+}
+
+var errSerialize = errors.New("serialize failed")
+
+func (s *failingSerializer) ConfigureSerializer(client schemaregistry.Client, serdeType serde.Type,
+	conf *serde.SerializerConfig) error {
+	return nil
+}
+
+func (s *failingSerializer) Serialize(topic string, msg interface{}) ([]byte, error) {
+	return nil, errSerialize
+}
+
+// TestProducerSerializationError verifies that serialization failures are
+// reported as *SerializationError and that nothing is produced.
+func TestProducerSerializationError(t *testing.T) {
+	p, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": "127.0.0.1:65533"})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	defer p.Close()
+
+	tp, err := NewProducer[string, string](p, &failingSerializer{}, NewRawSerializer())
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	_, err = tp.Produce(context.Background(), "gotest", "key", "value", nil)
+	var serr *SerializationError
+	if !errors.As(err, &serr) {
+		t.Fatalf("Expected SerializationError, got %v", err)
+	}
+	if serr.SerdeType != serde.KeySerde || serr.Topic != "gotest" || !errors.Is(err, errSerialize) {
+		t.Errorf("Unexpected SerializationError %v", serr)
+	}
+
+	if p.Len() != 0 {
+		t.Errorf("Expected no messages to be produced, got %d", p.Len())
+	}
+
+	tp, err = NewProducer[string, string](p, nil, &failingSerializer{})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	_, err = tp.Produce(context.Background(), "gotest", "key", "value", nil)
+	if !errors.As(err, &serr) || serr.SerdeType != serde.ValueSerde {
+		t.Errorf("Expected value SerializationError, got %v", err)
+	}
+}
+
+// TestProducerProduce verifies message construction and that Produce()
+// honours the context when no broker is available.
+func TestProducerProduce(t *testing.T) {
+	p, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers":   "127.0.0.1:65533",
+		"message.timeout.ms":  10000,
+		"socket.timeout.ms":   10,
+		"go.delivery.reports": false,
+	})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	defer p.Close()
+
+	_, err = NewProducer[string, string](nil, nil, NewRawSerializer())
+	if err == nil {
+		t.Errorf("Expected NewProducer() to fail without a producer")
+	}
+
+	tp, err := NewProducer[string, []byte](p, NewRawSerializer(), NewRawSerializer())
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	headers := []kafka.Header{{Key: "hdr", Value: []byte("val")}}
+	msg, err := tp.NewMessage("gotest", "key", []byte("value"), headers)
+	if err != nil {
+		t.Fatalf("NewMessage() failed: %s", err)
+	}
+	if *msg.TopicPartition.Topic != "gotest" || msg.TopicPartition.Partition != kafka.PartitionAny ||
+		string(msg.Key) != "key" || string(msg.Value) != "value" || len(msg.Headers) != 1 {
+		t.Errorf("Unexpected message %v", msg)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	msg, err = tp.Produce(ctx, "gotest", "key", []byte("value"), headers)
+	if err != context.DeadlineExceeded || msg != nil {
+		t.Errorf("Expected (nil, %v), got (%v, %v)", context.DeadlineExceeded, msg, err)
+	}
+
+	p.Purge(kafka.PurgeQueue | kafka.PurgeInFlight)
+}